@@ -0,0 +1,91 @@
+package eip7980
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	ErrInvalidAddress  = errors.New("invalid address")
+	ErrAddressChecksum = errors.New("address checksum mismatch")
+)
+
+// ParseAddress parses a 0x-prefixed, 40 hex character execution address
+//
+// Every decoder in this module (text, JSON, address book files, CLI flags)
+// goes through ParseAddress so they accept and reject the same inputs.
+// When strict is true the address must be in its EIP-55 checksummed form;
+// otherwise any letter case is accepted and the checksum is not checked.
+//
+// Errors wrap ErrInvalidAddress for malformed input and ErrAddressChecksum
+// for a well-formed address that fails the strict checksum check.
+func ParseAddress(s string, strict bool) (ExecutionAddress, error) {
+	var addr ExecutionAddress
+
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return addr, fmt.Errorf("%w %q: missing 0x prefix", ErrInvalidAddress, s)
+	}
+	if len(s) != 2+2*len(addr) {
+		return addr, fmt.Errorf("%w %q: expected %d hex characters", ErrInvalidAddress, s, 2*len(addr))
+	}
+	if _, err := hex.Decode(addr[:], []byte(s[2:])); err != nil {
+		return ExecutionAddress{}, fmt.Errorf("%w %q: %v", ErrInvalidAddress, s, err)
+	}
+
+	if strict && s[2:] != addr.ChecksumString()[2:] {
+		return ExecutionAddress{}, fmt.Errorf("%w: %q, expected %s", ErrAddressChecksum, s, addr.ChecksumString())
+	}
+	return addr, nil
+}
+
+// ChecksumString returns the EIP-55 mixed-case checksummed form of the address
+func (addr ExecutionAddress) ChecksumString() string {
+	lower := hex.EncodeToString(addr[:])
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lower))
+	digest := hash.Sum(nil)
+
+	result := []byte("0x" + lower)
+	for i := 0; i < len(lower); i++ {
+		nibble := digest[i/2] >> 4
+		if i%2 == 1 {
+			nibble = digest[i/2] & 0x0f
+		}
+		if lower[i] >= 'a' && nibble >= 8 {
+			result[2+i] = lower[i] - 'a' + 'A'
+		}
+	}
+	return string(result)
+}
+
+// Equal reports whether addr and other are the same address in constant time
+//
+// The comparison time does not depend on where the addresses differ, so it
+// is safe for comparing against secret or attacker-probed addresses.
+func (addr ExecutionAddress) Equal(other ExecutionAddress) bool {
+	return subtle.ConstantTimeCompare(addr[:], other[:]) == 1
+}
+
+// MarshalText encodes the address in the lowercase form returned by String
+func (addr ExecutionAddress) MarshalText() ([]byte, error) {
+	return []byte(addr.String()), nil
+}
+
+// UnmarshalText decodes an address with ParseAddress in non-strict mode
+func (addr *ExecutionAddress) UnmarshalText(text []byte) error {
+	if addr == nil {
+		return fmt.Errorf("%w: nil destination", ErrInvalidAddress)
+	}
+	parsed, err := ParseAddress(string(text), false)
+	if err != nil {
+		return err
+	}
+	*addr = parsed
+	return nil
+}
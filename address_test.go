@@ -0,0 +1,203 @@
+package eip7980
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"strings"
+	"testing"
+)
+
+// addressParseCases are shared by every decoder in TestAddressDecodersAgree
+var addressParseCases = []struct {
+	input  string
+	err    error // non-strict result
+	strict error // strict result
+}{
+	{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil, nil},
+	{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", nil, ErrAddressChecksum},
+	{"0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", nil, ErrAddressChecksum},
+	{"0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil, ErrAddressChecksum},
+	{"0x0000000000000000000000000000000000000000", nil, nil},
+	{"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ErrInvalidAddress, ErrInvalidAddress},
+	{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea", ErrInvalidAddress, ErrInvalidAddress},
+	{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed00", ErrInvalidAddress, ErrInvalidAddress},
+	{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beazz", ErrInvalidAddress, ErrInvalidAddress},
+	{"0x", ErrInvalidAddress, ErrInvalidAddress},
+	{"", ErrInvalidAddress, ErrInvalidAddress},
+	{" 0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ErrInvalidAddress, ErrInvalidAddress},
+}
+
+// TestParseAddressStrict tests EIP-55 checksum enforcement
+func TestParseAddressStrict(t *testing.T) {
+	for _, tc := range addressParseCases {
+		_, err := ParseAddress(tc.input, true)
+		if !matchesSentinel(err, tc.strict) {
+			t.Errorf("ParseAddress(%q, true): expected %v, got %v", tc.input, tc.strict, err)
+		}
+	}
+}
+
+// TestChecksumString tests the EIP-55 encoding against the EIP's own examples
+func TestChecksumString(t *testing.T) {
+	for _, want := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		addr, err := ParseAddress(want, true)
+		if err != nil {
+			t.Fatalf("ParseAddress(%q): %v", want, err)
+		}
+		if got := addr.ChecksumString(); got != want {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	}
+}
+
+// TestAddressDecodersAgree tests that every address decoder makes the same accept/reject decision
+func TestAddressDecodersAgree(t *testing.T) {
+	decoders := map[string]func(string) (ExecutionAddress, error){
+		"ParseAddress": func(s string) (ExecutionAddress, error) {
+			return ParseAddress(s, false)
+		},
+		"UnmarshalText": func(s string) (ExecutionAddress, error) {
+			var addr ExecutionAddress
+			err := addr.UnmarshalText([]byte(s))
+			return addr, err
+		},
+		"JSON": func(s string) (ExecutionAddress, error) {
+			quoted, _ := json.Marshal(s)
+			var addr ExecutionAddress
+			err := json.Unmarshal(quoted, &addr)
+			return addr, err
+		},
+		"flag": func(s string) (ExecutionAddress, error) {
+			var addr ExecutionAddress
+			flags := flag.NewFlagSet("test", flag.ContinueOnError)
+			flags.SetOutput(io.Discard)
+			flags.TextVar(&addr, "address", ExecutionAddress{}, "")
+			err := flags.Parse([]string{"--address=" + s})
+			return addr, err
+		},
+		"AddressBook.ReadJSON": func(s string) (ExecutionAddress, error) {
+			data, _ := json.Marshal([]map[string]string{{"label": "a", "address": s}})
+			book := NewAddressBook()
+			err := book.ReadJSON(strings.NewReader(string(data)), false)
+			addr, _ := book.Address("a")
+			return addr, err
+		},
+		"AddressBook.ReadCSV": func(s string) (ExecutionAddress, error) {
+			book := NewAddressBook()
+			err := book.ReadCSV(strings.NewReader("label,address\na,\""+s+"\"\n"), false)
+			addr, _ := book.Address("a")
+			return addr, err
+		},
+	}
+
+	for _, tc := range addressParseCases {
+		want, _ := ParseAddress(tc.input, false)
+		for name, decode := range decoders {
+			addr, err := decode(tc.input)
+			matches := matchesSentinel(err, tc.err)
+			if name == "flag" && err != nil && tc.err != nil {
+				// The flag package formats value errors with %v, dropping the wrap chain
+				matches = strings.Contains(err.Error(), tc.err.Error())
+			}
+			if !matches {
+				t.Errorf("%s(%q): expected %v, got %v", name, tc.input, tc.err, err)
+				continue
+			}
+			if err == nil && addr != want {
+				t.Errorf("%s(%q): expected %s, got %s", name, tc.input, want, addr)
+			}
+		}
+	}
+}
+
+// TestAddressJSONRoundTrip tests that addresses encode as JSON strings
+func TestAddressJSONRoundTrip(t *testing.T) {
+	addr, _ := ParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true)
+
+	data, err := json.Marshal(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	var decoded ExecutionAddress
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != addr {
+		t.Errorf("Round trip failed: %s, %v", decoded, err)
+	}
+}
+
+// matchesSentinel reports whether err is nil when want is nil, or wraps want otherwise
+func matchesSentinel(err, want error) bool {
+	if want == nil {
+		return err == nil
+	}
+	return errors.Is(err, want)
+}
+
+// TestEqualConstantTime tests that Equal compares with crypto/subtle
+//
+// Timing measurements cannot tell subtle.ConstantTimeCompare from bytes.Equal
+// on 20 bytes, so the source of Equal is checked instead: it must call
+// subtle.ConstantTimeCompare and must not loop or call a short-circuiting
+// comparison.
+func TestEqualConstantTime(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "address.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var equal *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil && fn.Name.Name == "Equal" {
+			equal = fn
+		}
+	}
+	if equal == nil {
+		t.Fatal("ExecutionAddress.Equal not found in address.go")
+	}
+
+	constantTime := false
+	ast.Inspect(equal.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			t.Errorf("Equal must not loop over the address bytes")
+		case *ast.BinaryExpr:
+			if _, ok := node.X.(*ast.CallExpr); !ok && (node.Op == token.EQL || node.Op == token.NEQ) {
+				t.Errorf("Equal must not compare addresses with %s", node.Op)
+			}
+		case *ast.SelectorExpr:
+			if pkg, ok := node.X.(*ast.Ident); ok {
+				switch pkg.Name + "." + node.Sel.Name {
+				case "subtle.ConstantTimeCompare":
+					constantTime = true
+				case "bytes.Equal", "bytes.Compare", "reflect.DeepEqual", "slices.Equal":
+					t.Errorf("Equal must not call %s.%s", pkg.Name, node.Sel.Name)
+				}
+			}
+		}
+		return true
+	})
+	if !constantTime {
+		t.Errorf("Expected Equal to call subtle.ConstantTimeCompare")
+	}
+
+	a := KnownAnswerTests[0].Address
+	firstDiffers, lastDiffers := a, a
+	firstDiffers[0] ^= 0x01
+	lastDiffers[len(lastDiffers)-1] ^= 0x01
+	if !a.Equal(a) || a.Equal(firstDiffers) || a.Equal(lastDiffers) {
+		t.Errorf("Equal returned wrong results")
+	}
+}
@@ -0,0 +1,254 @@
+package eip7980
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Errors returned by AddressBook
+var (
+	ErrLabelConflict = errors.New("address book conflict")
+	ErrEmptyLabel    = errors.New("address book label is empty")
+	errNilBook       = errors.New("nil address book")
+	errNilReader     = errors.New("nil reader")
+	errNilWriter     = errors.New("nil writer")
+)
+
+// addressBookEntry is the serialized form of one label
+type addressBookEntry struct {
+	Label   string `json:"label"`
+	Address string `json:"address"`
+}
+
+// AddressBook maps human-readable labels to addresses and back
+//
+// Labels and addresses are one-to-one: assigning a label that is already
+// bound to a different address, or an address that already has a different
+// label, is a conflict unless forced. AddressBook is safe for concurrent use.
+// The zero value is an empty book; a nil *AddressBook reads as empty and
+// rejects writes.
+type AddressBook struct {
+	mu        sync.RWMutex
+	byLabel   map[string]ExecutionAddress
+	byAddress map[ExecutionAddress]string
+}
+
+// NewAddressBook creates an empty address book
+func NewAddressBook() *AddressBook {
+	return &AddressBook{
+		byLabel:   make(map[string]ExecutionAddress),
+		byAddress: make(map[ExecutionAddress]string),
+	}
+}
+
+// Set binds label to addr, returning ErrLabelConflict if either is already bound elsewhere
+func (b *AddressBook) Set(label string, addr ExecutionAddress) error {
+	return b.set(label, addr, false)
+}
+
+// SetForce binds label to addr, replacing any existing binding of either
+func (b *AddressBook) SetForce(label string, addr ExecutionAddress) error {
+	return b.set(label, addr, true)
+}
+
+// set implements Set and SetForce under the write lock
+func (b *AddressBook) set(label string, addr ExecutionAddress, force bool) error {
+	if b == nil {
+		return errNilBook
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.byLabel == nil {
+		b.byLabel = make(map[string]ExecutionAddress)
+		b.byAddress = make(map[ExecutionAddress]string)
+	}
+	return bindLabel(b.byLabel, b.byAddress, label, addr, force)
+}
+
+// bindLabel binds label to addr in the two index maps, keeping them one-to-one
+func bindLabel(byLabel map[string]ExecutionAddress, byAddress map[ExecutionAddress]string, label string, addr ExecutionAddress, force bool) error {
+	if label == "" {
+		return ErrEmptyLabel
+	}
+
+	existing, labelTaken := byLabel[label]
+	existingLabel, addressTaken := byAddress[addr]
+
+	if !force {
+		if labelTaken && existing != addr {
+			return fmt.Errorf("%w: label %q is already %s", ErrLabelConflict, label, existing)
+		}
+		if addressTaken && existingLabel != label {
+			return fmt.Errorf("%w: %s is already labeled %q", ErrLabelConflict, addr, existingLabel)
+		}
+	}
+
+	if labelTaken {
+		delete(byAddress, existing)
+	}
+	if addressTaken {
+		delete(byLabel, existingLabel)
+	}
+	byLabel[label] = addr
+	byAddress[addr] = label
+	return nil
+}
+
+// Address returns the address bound to label
+func (b *AddressBook) Address(label string) (ExecutionAddress, bool) {
+	if b == nil {
+		return ExecutionAddress{}, false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	addr, ok := b.byLabel[label]
+	return addr, ok
+}
+
+// Label returns the label bound to addr
+func (b *AddressBook) Label(addr ExecutionAddress) (string, bool) {
+	if b == nil {
+		return "", false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	label, ok := b.byAddress[addr]
+	return label, ok
+}
+
+// Len returns the number of labels in the book
+func (b *AddressBook) Len() int {
+	if b == nil {
+		return 0
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.byLabel)
+}
+
+// entries returns the book's contents sorted by label
+func (b *AddressBook) entries() []addressBookEntry {
+	if b == nil {
+		return []addressBookEntry{}
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	entries := make([]addressBookEntry, 0, len(b.byLabel))
+	for label, addr := range b.byLabel {
+		entries = append(entries, addressBookEntry{Label: label, Address: addr.String()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Label < entries[j].Label })
+	return entries
+}
+
+// load parses and adds entries all or nothing
+//
+// Every address is parsed and every entry applied to a copy of the book
+// first; the copy replaces the book's contents only if no entry is invalid
+// or conflicting, so a failed import leaves the book unchanged.
+func (b *AddressBook) load(entries []addressBookEntry, force bool) error {
+	if b == nil {
+		return errNilBook
+	}
+
+	addrs := make([]ExecutionAddress, len(entries))
+	for i, entry := range entries {
+		addr, err := ParseAddress(entry.Address, false)
+		if err != nil {
+			return fmt.Errorf("entry %d (%q): %w", i, entry.Label, err)
+		}
+		addrs[i] = addr
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	byLabel := make(map[string]ExecutionAddress, len(b.byLabel)+len(entries))
+	byAddress := make(map[ExecutionAddress]string, len(b.byAddress)+len(entries))
+	for label, addr := range b.byLabel {
+		byLabel[label] = addr
+		byAddress[addr] = label
+	}
+	for i, entry := range entries {
+		if err := bindLabel(byLabel, byAddress, entry.Label, addrs[i], force); err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+
+	b.byLabel, b.byAddress = byLabel, byAddress
+	return nil
+}
+
+// WriteJSON exports the book as a JSON array of {"label", "address"} objects sorted by label
+func (b *AddressBook) WriteJSON(w io.Writer) error {
+	if w == nil {
+		return errNilWriter
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b.entries())
+}
+
+// ReadJSON imports entries written by WriteJSON; force resolves conflicts in favour of the file
+//
+// The import is atomic: on any error the book is left unchanged.
+func (b *AddressBook) ReadJSON(r io.Reader, force bool) error {
+	if r == nil {
+		return errNilReader
+	}
+	var entries []addressBookEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return fmt.Errorf("invalid address book JSON: %w", err)
+	}
+	return b.load(entries, force)
+}
+
+// WriteCSV exports the book as CSV with a "label,address" header, sorted by label
+func (b *AddressBook) WriteCSV(w io.Writer) error {
+	if w == nil {
+		return errNilWriter
+	}
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"label", "address"})
+	for _, entry := range b.entries() {
+		writer.Write([]string{entry.Label, entry.Address})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadCSV imports entries written by WriteCSV; force resolves conflicts in favour of the file
+//
+// The import is atomic: on any error the book is left unchanged.
+func (b *AddressBook) ReadCSV(r io.Reader, force bool) error {
+	if r == nil {
+		return errNilReader
+	}
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return fmt.Errorf("invalid address book CSV: %w", err)
+	}
+	if len(records) == 0 || records[0][0] != "label" || records[0][1] != "address" {
+		return errors.New("invalid address book CSV: missing label,address header")
+	}
+
+	entries := make([]addressBookEntry, len(records)-1)
+	for i, record := range records[1:] {
+		entries[i] = addressBookEntry{Label: record[0], Address: record[1]}
+	}
+	return b.load(entries, force)
+}
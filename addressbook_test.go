@@ -0,0 +1,188 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestAddressBookLookup tests label and address lookups in both directions
+func TestAddressBookLookup(t *testing.T) {
+	book := NewAddressBook()
+	alice := ExecutionAddress{19: 0xa1}
+
+	if err := book.Set("alice", alice); err != nil {
+		t.Fatal(err)
+	}
+	// Re-setting the same binding is not a conflict
+	if err := book.Set("alice", alice); err != nil {
+		t.Errorf("Idempotent Set failed: %v", err)
+	}
+
+	if addr, ok := book.Address("alice"); !ok || addr != alice {
+		t.Errorf("Address(alice) = %s, %v", addr, ok)
+	}
+	if label, ok := book.Label(alice); !ok || label != "alice" {
+		t.Errorf("Label = %q, %v", label, ok)
+	}
+	if _, ok := book.Address("bob"); ok {
+		t.Errorf("Unknown label found")
+	}
+	if err := book.Set("", alice); err != ErrEmptyLabel {
+		t.Errorf("Expected ErrEmptyLabel, got %v", err)
+	}
+}
+
+// TestAddressBookConflicts tests conflict detection and forced overrides
+func TestAddressBookConflicts(t *testing.T) {
+	book := NewAddressBook()
+	first, second := ExecutionAddress{19: 0x01}, ExecutionAddress{19: 0x02}
+	book.Set("validator", first)
+
+	if err := book.Set("validator", second); !errors.Is(err, ErrLabelConflict) {
+		t.Errorf("Same label, different address: expected ErrLabelConflict, got %v", err)
+	}
+	if err := book.Set("other", first); !errors.Is(err, ErrLabelConflict) {
+		t.Errorf("Same address, different label: expected ErrLabelConflict, got %v", err)
+	}
+
+	if err := book.SetForce("validator", second); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := book.Label(first); ok {
+		t.Errorf("Forced Set left the old address labeled")
+	}
+	if addr, _ := book.Address("validator"); addr != second || book.Len() != 1 {
+		t.Errorf("Forced Set did not replace binding")
+	}
+}
+
+// TestAddressBookRoundTrip tests JSON and CSV export/import
+func TestAddressBookRoundTrip(t *testing.T) {
+	book := NewAddressBook()
+	book.Set("bob", ExecutionAddress{0: 0xb0, 19: 0x0b})
+	book.Set("alice", ExecutionAddress{19: 0xa1})
+
+	var jsonBuf, csvBuf bytes.Buffer
+	if err := book.WriteJSON(&jsonBuf); err != nil {
+		t.Fatal(err)
+	}
+	if err := book.WriteCSV(&csvBuf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(csvBuf.String(), "label,address\nalice,0x") {
+		t.Errorf("Unexpected CSV output:\n%s", csvBuf.String())
+	}
+
+	fromJSON, fromCSV := NewAddressBook(), NewAddressBook()
+	if err := fromJSON.ReadJSON(&jsonBuf, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := fromCSV.ReadCSV(&csvBuf, false); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, imported := range []*AddressBook{fromJSON, fromCSV} {
+		if imported.Len() != 2 {
+			t.Fatalf("Imported %d entries, want 2", imported.Len())
+		}
+		for _, label := range []string{"alice", "bob"} {
+			want, _ := book.Address(label)
+			if got, _ := imported.Address(label); got != want {
+				t.Errorf("%s = %s, want %s", label, got, want)
+			}
+		}
+	}
+}
+
+// TestAddressBookMalformed tests rejection of malformed files
+func TestAddressBookMalformed(t *testing.T) {
+	jsonInputs := []string{
+		`{`,
+		`[{"label":"a","address":"0x1234"}]`,
+		`[{"label":"a","address":"0000000000000000000000000000000000000001"}]`,
+		`[{"label":"","address":"0x0000000000000000000000000000000000000001"}]`,
+	}
+	for _, input := range jsonInputs {
+		if err := NewAddressBook().ReadJSON(strings.NewReader(input), false); err == nil {
+			t.Errorf("Expected error for JSON %s", input)
+		}
+	}
+
+	csvInputs := []string{
+		"a,0x0000000000000000000000000000000000000001\n",
+		"label,address\na,0xzz00000000000000000000000000000000000001\n",
+		"label,address\na,0x0000000000000000000000000000000000000001,extra\n",
+	}
+	for _, input := range csvInputs {
+		if err := NewAddressBook().ReadCSV(strings.NewReader(input), false); err == nil {
+			t.Errorf("Expected error for CSV %q", input)
+		}
+	}
+
+	conflicting := "label,address\na,0x0000000000000000000000000000000000000001\na,0x0000000000000000000000000000000000000002\n"
+	if err := NewAddressBook().ReadCSV(strings.NewReader(conflicting), false); !errors.Is(err, ErrLabelConflict) {
+		t.Errorf("Expected ErrLabelConflict, got %v", err)
+	}
+	if err := NewAddressBook().ReadCSV(strings.NewReader(conflicting), true); err != nil {
+		t.Errorf("Forced import failed: %v", err)
+	}
+}
+
+// TestAddressBookImportAtomic tests that a failed import leaves the book unchanged
+func TestAddressBookImportAtomic(t *testing.T) {
+	book := NewAddressBook()
+	existing := ExecutionAddress{19: 0x01}
+	book.Set("existing", existing)
+
+	inputs := map[string]string{
+		"invalid address": "label,address\n" +
+			"new,0x0000000000000000000000000000000000000002\n" +
+			"bad,0x1234\n",
+		"conflict": "label,address\n" +
+			"new,0x0000000000000000000000000000000000000002\n" +
+			"existing,0x0000000000000000000000000000000000000003\n",
+	}
+	for name, input := range inputs {
+		if err := book.ReadCSV(strings.NewReader(input), false); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+		if _, ok := book.Address("new"); ok || book.Len() != 1 {
+			t.Errorf("%s: failed import added entries, book has %d", name, book.Len())
+		}
+		if addr, _ := book.Address("existing"); addr != existing {
+			t.Errorf("%s: failed import changed an existing entry to %s", name, addr)
+		}
+	}
+
+	valid := "label,address\nnew,0x0000000000000000000000000000000000000002\n"
+	if err := book.ReadCSV(strings.NewReader(valid), false); err != nil || book.Len() != 2 {
+		t.Errorf("Valid import failed: %v, book has %d", err, book.Len())
+	}
+}
+
+// TestAddressBookConcurrent tests concurrent writers and readers under -race
+func TestAddressBookConcurrent(t *testing.T) {
+	book := NewAddressBook()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			addr := ExecutionAddress{19: byte(i)}
+			label := string(rune('a' + i))
+			book.Set(label, addr)
+			book.Address(label)
+			book.Label(addr)
+			book.WriteJSON(&bytes.Buffer{})
+		}(i)
+	}
+	wg.Wait()
+
+	if book.Len() != 16 {
+		t.Errorf("Len = %d, want 16", book.Len())
+	}
+}
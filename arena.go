@@ -0,0 +1,113 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ARENA_SLAB_SIZE is the number of SignatureInfos allocated per arena slab
+const ARENA_SLAB_SIZE = 1024
+
+// arenaPoison is written over every handed-out SignatureInfo on Reset in race builds
+const arenaPoison = 0xa5
+
+// errNilSignatureInfo is returned when decoding into a nil destination
+var errNilSignatureInfo = errors.New("nil signature info")
+
+// ParseSignatureInfoInto decodes data into dst without allocating
+//
+// It applies the same length check as ParseSignatureInfo and leaves dst
+// unchanged on error.
+func ParseSignatureInfoInto(dst *SignatureInfo, data []byte) error {
+	if dst == nil {
+		return errNilSignatureInfo
+	}
+	if len(data) != MAX_SIZE {
+		return fmt.Errorf("invalid data length: expected %d, got %d", MAX_SIZE, len(data))
+	}
+
+	copy(dst.Signature[:], data[:64])
+	copy(dst.PublicKey[:], data[64:96])
+	return nil
+}
+
+// Arena hands out SignatureInfos from pre-allocated slabs for bulk decoding
+//
+// Arena is not safe for concurrent use; use one arena per worker. Pointers
+// returned by Get are valid until the next Reset, after which the memory is
+// reused. Race-enabled builds poison handed-out entries on Reset so stale
+// pointers read as 0xa5 bytes instead of silently aliasing new data. The
+// zero value is ready to use; a nil *Arena allocates each entry individually.
+type Arena struct {
+	slabs [][]SignatureInfo
+	slab  int // index of the slab currently being filled
+	next  int // next free entry in slabs[slab]
+}
+
+// Get returns a zeroed SignatureInfo owned by the arena
+func (a *Arena) Get() *SignatureInfo {
+	if a == nil {
+		return new(SignatureInfo)
+	}
+
+	if a.slab == len(a.slabs) {
+		a.slabs = append(a.slabs, make([]SignatureInfo, ARENA_SLAB_SIZE))
+	}
+	info := &a.slabs[a.slab][a.next]
+	*info = SignatureInfo{}
+
+	a.next++
+	if a.next == ARENA_SLAB_SIZE {
+		a.slab, a.next = a.slab+1, 0
+	}
+	return info
+}
+
+// Parse decodes data into a SignatureInfo from the arena
+func (a *Arena) Parse(data []byte) (*SignatureInfo, error) {
+	if len(data) != MAX_SIZE {
+		return nil, fmt.Errorf("invalid data length: expected %d, got %d", MAX_SIZE, len(data))
+	}
+
+	info := a.Get()
+	ParseSignatureInfoInto(info, data)
+	return info, nil
+}
+
+// Reset makes every slab available again, invalidating all pointers from Get
+func (a *Arena) Reset() {
+	if a == nil {
+		return
+	}
+
+	if raceEnabled {
+		for i := 0; i <= a.slab && i < len(a.slabs); i++ {
+			used := a.slabs[i]
+			if i == a.slab {
+				used = used[:a.next]
+			}
+			for j := range used {
+				poisonSignatureInfo(&used[j])
+			}
+		}
+	}
+	a.slab, a.next = 0, 0
+}
+
+// Len returns the number of SignatureInfos handed out since the last Reset
+func (a *Arena) Len() int {
+	if a == nil {
+		return 0
+	}
+	return a.slab*ARENA_SLAB_SIZE + a.next
+}
+
+// poisonSignatureInfo overwrites info with arenaPoison
+func poisonSignatureInfo(info *SignatureInfo) {
+	for i := range info.Signature {
+		info.Signature[i] = arenaPoison
+	}
+	for i := range info.PublicKey {
+		info.PublicKey[i] = arenaPoison
+	}
+}
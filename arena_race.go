@@ -0,0 +1,6 @@
+//go:build race
+
+package eip7980
+
+// raceEnabled makes Arena.Reset poison released entries
+const raceEnabled = true
@@ -0,0 +1,125 @@
+package eip7980
+
+import (
+	"runtime"
+	"testing"
+)
+
+// TestArenaParse tests arena decoding across slab boundaries and reuse after Reset
+func TestArenaParse(t *testing.T) {
+	signatureInfo := newTestSignatureInfo(t, [32]byte{})
+	want, _ := ParseSignatureInfo(signatureInfo)
+
+	var arena Arena
+	infos := make([]*SignatureInfo, ARENA_SLAB_SIZE+10)
+	for i := range infos {
+		info, err := arena.Parse(signatureInfo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos[i] = info
+	}
+	if arena.Len() != len(infos) || len(arena.slabs) != 2 {
+		t.Fatalf("Expected %d entries in 2 slabs, got %d in %d", len(infos), arena.Len(), len(arena.slabs))
+	}
+	for i, info := range infos {
+		if *info != *want {
+			t.Fatalf("Entry %d decoded incorrectly", i)
+		}
+	}
+
+	if _, err := arena.Parse(signatureInfo[:95]); err == nil {
+		t.Errorf("Expected length error")
+	}
+	if arena.Len() != len(infos) {
+		t.Errorf("Failed parse consumed an entry")
+	}
+
+	arena.Reset()
+	if arena.Len() != 0 {
+		t.Errorf("Expected empty arena after Reset, got %d", arena.Len())
+	}
+	if reused := arena.Get(); reused != infos[0] || *reused != (SignatureInfo{}) {
+		t.Errorf("Expected Get after Reset to reuse the first entry, zeroed")
+	}
+	if len(arena.slabs) != 2 {
+		t.Errorf("Reset released slabs")
+	}
+}
+
+// TestArenaResetPoisons tests that Reset deterministically invalidates prior pointers in race builds
+func TestArenaResetPoisons(t *testing.T) {
+	if !raceEnabled {
+		t.Skip("poisoning is only enabled with -race")
+	}
+
+	var arena Arena
+	stale, err := arena.Parse(newTestSignatureInfo(t, [32]byte{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	arena.Reset()
+
+	for i, b := range stale.ToBytes() {
+		if b != arenaPoison {
+			t.Fatalf("Byte %d of stale entry is %#x, expected poison %#x", i, b, arenaPoison)
+		}
+	}
+}
+
+// TestParseSignatureInfoInto tests that decoding errors leave the destination unchanged
+func TestParseSignatureInfoInto(t *testing.T) {
+	signatureInfo := newTestSignatureInfo(t, [32]byte{})
+
+	var dst SignatureInfo
+	if err := ParseSignatureInfoInto(&dst, signatureInfo); err != nil {
+		t.Fatal(err)
+	}
+	before := dst
+	if err := ParseSignatureInfoInto(&dst, make([]byte, 95)); err == nil || dst != before {
+		t.Errorf("Expected length error with dst unchanged, got %v", err)
+	}
+	if err := ParseSignatureInfoInto(nil, signatureInfo); err == nil {
+		t.Errorf("Expected error for nil destination")
+	}
+}
+
+// benchmarkDecodeCount is the size of the decode workload in the arena benchmarks
+const benchmarkDecodeCount = 100_000
+
+// BenchmarkDecodeIndividual decodes 100k records with one allocation each
+func BenchmarkDecodeIndividual(b *testing.B) {
+	signatureInfo := make([]byte, MAX_SIZE)
+	infos := make([]*SignatureInfo, benchmarkDecodeCount)
+	pauses := gcPauseTotal()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range infos {
+			infos[j], _ = ParseSignatureInfo(signatureInfo)
+		}
+	}
+	b.ReportMetric(float64(gcPauseTotal()-pauses)/float64(b.N), "gc-pause-ns/op")
+}
+
+// BenchmarkDecodeArena decodes 100k records into a reused Arena
+func BenchmarkDecodeArena(b *testing.B) {
+	signatureInfo := make([]byte, MAX_SIZE)
+	infos := make([]*SignatureInfo, benchmarkDecodeCount)
+	var arena Arena
+	pauses := gcPauseTotal()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		arena.Reset()
+		for j := range infos {
+			infos[j], _ = arena.Parse(signatureInfo)
+		}
+	}
+	b.ReportMetric(float64(gcPauseTotal()-pauses)/float64(b.N), "gc-pause-ns/op")
+}
+
+// gcPauseTotal returns the cumulative GC stop-the-world pause time in nanoseconds
+func gcPauseTotal() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.PauseTotalNs
+}
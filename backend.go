@@ -0,0 +1,68 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"sync/atomic"
+)
+
+// Backend is the Ed25519 signature check used by EIP-7980 verification
+//
+// Implementations must perform raw RFC 8032 Ed25519 verification (not
+// Ed25519ctx or Ed25519ph) and must not retain or modify their arguments.
+// Public key validation, length checks and address derivation stay in this
+// package regardless of the backend.
+type Backend interface {
+	Verify(publicKey, message, signature []byte) bool
+}
+
+// StdlibBackend verifies with crypto/ed25519; it is the default Backend
+type StdlibBackend struct{}
+
+// Verify reports whether signature is a valid crypto/ed25519 signature of message by publicKey
+//
+// Unlike ed25519.Verify it returns false instead of panicking on a wrong-length key.
+func (StdlibBackend) Verify(publicKey, message, signature []byte) bool {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(publicKey, message, signature)
+}
+
+// packageBackend holds the Backend installed by SetBackend; nil means StdlibBackend
+var packageBackend atomic.Pointer[Backend]
+
+// SetBackend replaces the Backend used by Verify and the other package-level
+// verification functions; nil restores StdlibBackend
+//
+// It is safe to call concurrently with verification, but is meant to be set
+// once at startup. Every package-level helper, including VerifySolanaMessage
+// and VerifyADR036, uses the installed backend; only a Verifier with its own
+// Backend field overrides it.
+func SetBackend(backend Backend) {
+	if backend == nil {
+		packageBackend.Store(nil)
+		return
+	}
+	packageBackend.Store(&backend)
+}
+
+// currentBackend returns the Backend installed by SetBackend, or nil for the stdlib
+func currentBackend() Backend {
+	if backend := packageBackend.Load(); backend != nil {
+		return *backend
+	}
+	return nil
+}
+
+// verifyEd25519 checks signature over message with backend, or with
+// crypto/ed25519 directly when backend is nil
+//
+// The default path calls crypto/ed25519 without an interface so message,
+// usually a payload hash, stays on the caller's stack; custom backends get a
+// heap copy.
+func verifyEd25519(backend Backend, publicKey, message, signature []byte) bool {
+	if backend == nil {
+		return ed25519.Verify(publicKey, message, signature)
+	}
+	return backend.Verify(publicKey, append([]byte(nil), message...), signature)
+}
@@ -0,0 +1,79 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// stubBackend records each call and returns a fixed result
+type stubBackend struct {
+	result bool
+	calls  int
+	last   [3][]byte
+}
+
+func (s *stubBackend) Verify(publicKey, message, signature []byte) bool {
+	s.calls++
+	s.last = [3][]byte{publicKey, message, signature}
+	return s.result
+}
+
+// TestSetBackendDelegates tests that package-level and Verifier checks go through the installed Backend
+func TestSetBackendDelegates(t *testing.T) {
+	payloadHash := [32]byte{0x66}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	forged := append([]byte(nil), signatureInfo...)
+	forged[0] ^= 0xff
+
+	stub := &stubBackend{result: true}
+	SetBackend(stub)
+	t.Cleanup(func() { SetBackend(nil) })
+
+	if _, err := Verify(forged, payloadHash); err != nil {
+		t.Errorf("Expected the stub to accept, got %v", err)
+	}
+	if stub.calls != 1 {
+		t.Fatalf("Expected 1 backend call, got %d", stub.calls)
+	}
+	if !bytes.Equal(stub.last[0], forged[64:]) || !bytes.Equal(stub.last[1], payloadHash[:]) || !bytes.Equal(stub.last[2], forged[:64]) {
+		t.Errorf("Backend got unexpected arguments")
+	}
+
+	// Length and key checks still run before the backend
+	if _, err := Verify(forged[:95], payloadHash); err == nil || stub.calls != 1 {
+		t.Errorf("Expected a length error without a backend call, got %v after %d calls", err, stub.calls)
+	}
+
+	// A Verifier's own Backend overrides the package one
+	rejecting := &stubBackend{}
+	verifier := NewVerifier()
+	verifier.Backend = rejecting
+	if _, err := verifier.Verify(signatureInfo, payloadHash); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if rejecting.calls != 1 || stub.calls != 1 {
+		t.Errorf("Expected only the Verifier backend to be called, got %d and %d calls", rejecting.calls, stub.calls)
+	}
+
+	SetBackend(nil)
+	if _, err := Verify(forged, payloadHash); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected the stdlib to reject after reset, got %v", err)
+	}
+	if _, err := Verify(signatureInfo, payloadHash); err != nil {
+		t.Errorf("Expected the stdlib to accept, got %v", err)
+	}
+}
+
+// TestStdlibBackend tests that StdlibBackend agrees with Verify
+func TestStdlibBackend(t *testing.T) {
+	payloadHash := [32]byte{0x77}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	if !(StdlibBackend{}).Verify(signatureInfo[64:], payloadHash[:], signatureInfo[:64]) {
+		t.Errorf("Expected a valid signature")
+	}
+	if (StdlibBackend{}).Verify(signatureInfo[64:], make([]byte, 32), signatureInfo[:64]) {
+		t.Errorf("Expected rejection for a different message")
+	}
+}
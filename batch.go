@@ -0,0 +1,151 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+)
+
+// ErrAddressMismatch is returned when a signature verifies but derives a different address than declared
+var ErrAddressMismatch = errors.New("derived address does not match expected sender")
+
+// BatchItem is one entry of a batch verification request
+type BatchItem struct {
+	SignatureInfo []byte
+	PayloadHash   [32]byte
+
+	// Meta carries caller provenance (peer, RPC request, ...) through to
+	// failure records. It is never modified by this package.
+	Meta map[string]string
+}
+
+// IndexedError reports the failure of a single batch item
+type IndexedError struct {
+	Index int               // Position of the item in the batch
+	Meta  map[string]string // The item's Meta, shared with the caller's map
+	Err   error             // Underlying verification error
+}
+
+// Error formats the failure with its index and sorted metadata
+func (e *IndexedError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	if len(e.Meta) == 0 {
+		return fmt.Sprintf("batch item %d: %v", e.Index, e.Err)
+	}
+
+	keys := make([]string, 0, len(e.Meta))
+	for key := range e.Meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + e.Meta[key]
+	}
+	return fmt.Sprintf("batch item %d (%s): %v", e.Index, strings.Join(pairs, ", "), e.Err)
+}
+
+// Unwrap returns the underlying verification error
+func (e *IndexedError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// LogValue implements slog.LogValuer so failures log the index, error and
+// each metadata entry as structured attributes
+func (e *IndexedError) LogValue() slog.Value {
+	if e == nil {
+		return slog.GroupValue()
+	}
+
+	attrs := []slog.Attr{slog.Int("index", e.Index)}
+	if e.Err != nil {
+		attrs = append(attrs, slog.String("error", e.Err.Error()))
+	}
+
+	keys := make([]string, 0, len(e.Meta))
+	for key := range e.Meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	metaAttrs := make([]any, len(keys))
+	for i, key := range keys {
+		metaAttrs[i] = slog.String(key, e.Meta[key])
+	}
+	if len(metaAttrs) > 0 {
+		attrs = append(attrs, slog.Group("meta", metaAttrs...))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// BatchError lists every failed item of a batch in input order
+type BatchError []*IndexedError
+
+// Error summarises the failures, showing the first one in full
+func (e BatchError) Error() string {
+	if len(e) == 0 {
+		return "no batch items failed"
+	}
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	return fmt.Sprintf("%d batch items failed, first: %v", len(e), e[0])
+}
+
+// VerifyBatch verifies each item independently
+//
+// Returns the derived address for every item (zero for failed items) and,
+// if any item failed, a BatchError holding one IndexedError per failure with
+// that item's Meta attached.
+func VerifyBatch(items []BatchItem) ([]ExecutionAddress, error) {
+	verifier := NewVerifier()
+	addresses := make([]ExecutionAddress, len(items))
+
+	var failures BatchError
+	for i, item := range items {
+		address, err := verifier.Verify(item.SignatureInfo, item.PayloadHash)
+		if err != nil {
+			failures = append(failures, &IndexedError{Index: i, Meta: item.Meta, Err: err})
+			continue
+		}
+		addresses[i] = address
+	}
+
+	if len(failures) > 0 {
+		return addresses, failures
+	}
+	return addresses, nil
+}
+
+// VerifyBatchAgainstAddresses verifies each signature and checks it derives the expected sender
+//
+// This is the block-validation shape where every transaction declares its
+// sender. The three slices must have equal length. Verification stops at the
+// first failing item, returned as an *IndexedError carrying its index; a
+// valid signature from the wrong key wraps ErrAddressMismatch.
+func VerifyBatchAgainstAddresses(infos [][]byte, hashes [][32]byte, expected []ExecutionAddress) error {
+	if len(infos) != len(hashes) || len(infos) != len(expected) {
+		return fmt.Errorf("batch length mismatch: %d signature infos, %d hashes, %d expected addresses", len(infos), len(hashes), len(expected))
+	}
+
+	verifier := NewVerifier()
+	for i := range infos {
+		address, err := verifier.Verify(infos[i], hashes[i])
+		if err != nil {
+			return &IndexedError{Index: i, Err: err}
+		}
+		if address != expected[i] {
+			return &IndexedError{Index: i, Err: fmt.Errorf("%w: expected %s, got %s", ErrAddressMismatch, expected[i], address)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// TestVerifyBatchMeta tests that failure records carry their own item's metadata only
+func TestVerifyBatchMeta(t *testing.T) {
+	payloadHash := [32]byte{0x42}
+	items := []BatchItem{
+		{SignatureInfo: newTestSignatureInfo(t, payloadHash), PayloadHash: payloadHash, Meta: map[string]string{"peer": "a"}},
+		{SignatureInfo: newTestSignatureInfo(t, payloadHash), PayloadHash: [32]byte{}, Meta: map[string]string{"peer": "b", "rpc": "7"}},
+		{SignatureInfo: make([]byte, 10), PayloadHash: payloadHash, Meta: map[string]string{"peer": "c"}},
+	}
+
+	addresses, err := VerifyBatch(items)
+
+	var failures BatchError
+	if !errors.As(err, &failures) {
+		t.Fatalf("Expected BatchError, got %v", err)
+	}
+	if len(failures) != 2 || failures[0].Index != 1 || failures[1].Index != 2 {
+		t.Fatalf("Unexpected failures: %v", failures)
+	}
+	if failures[0].Meta["peer"] != "b" || failures[0].Meta["rpc"] != "7" || failures[1].Meta["peer"] != "c" {
+		t.Errorf("Failure metadata does not match items: %v", failures)
+	}
+	if !errors.Is(failures[0], ErrInvalidSignature) {
+		t.Errorf("IndexedError does not unwrap to ErrInvalidSignature")
+	}
+	if !strings.Contains(failures[0].Error(), "peer=b, rpc=7") {
+		t.Errorf("Error text missing metadata: %s", failures[0])
+	}
+
+	if addresses[0] != deriveAddress(items[0].SignatureInfo[64:]) || addresses[1] != (ExecutionAddress{}) {
+		t.Errorf("Unexpected addresses: %v", addresses)
+	}
+
+	// Metadata maps must be left untouched
+	if len(items[0].Meta) != 1 || len(items[1].Meta) != 2 || len(items[2].Meta) != 1 {
+		t.Errorf("Item metadata was modified")
+	}
+}
+
+// TestIndexedErrorLogValue tests slog attributes of a failure record
+func TestIndexedErrorLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	failure := &IndexedError{Index: 3, Meta: map[string]string{"peer": "p1"}, Err: ErrInvalidSignature}
+	logger.Error("verification failed", "item", failure)
+
+	out := buf.String()
+	for _, want := range []string{"item.index=3", "item.meta.peer=p1", "item.error="} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Log output missing %q: %s", want, out)
+		}
+	}
+}
+
+// TestVerifyBatchAllValid tests that a fully valid batch returns no error
+func TestVerifyBatchAllValid(t *testing.T) {
+	payloadHash := [32]byte{0x01}
+	items := []BatchItem{
+		{SignatureInfo: newTestSignatureInfo(t, payloadHash), PayloadHash: payloadHash},
+		{SignatureInfo: newTestSignatureInfo(t, payloadHash), PayloadHash: payloadHash},
+	}
+
+	if _, err := VerifyBatch(items); err != nil {
+		t.Errorf("VerifyBatch failed: %v", err)
+	}
+}
+
+// TestVerifyBatchAgainstAddresses tests that the first mismatched sender is reported by index
+func TestVerifyBatchAgainstAddresses(t *testing.T) {
+	var infos [][]byte
+	var hashes [][32]byte
+	var expected []ExecutionAddress
+	for i := 0; i < 4; i++ {
+		payloadHash := [32]byte{byte(i)}
+		signatureInfo := newTestSignatureInfo(t, payloadHash)
+		infos = append(infos, signatureInfo)
+		hashes = append(hashes, payloadHash)
+		expected = append(expected, deriveAddress(signatureInfo[64:]))
+	}
+
+	if err := VerifyBatchAgainstAddresses(infos, hashes, expected); err != nil {
+		t.Fatalf("Expected valid batch, got %v", err)
+	}
+
+	expected[2], expected[3] = expected[3], expected[2]
+	err := VerifyBatchAgainstAddresses(infos, hashes, expected)
+	var indexed *IndexedError
+	if !errors.As(err, &indexed) || indexed.Index != 2 {
+		t.Fatalf("Expected IndexedError at index 2, got %v", err)
+	}
+	if !errors.Is(err, ErrAddressMismatch) {
+		t.Errorf("Expected ErrAddressMismatch, got %v", err)
+	}
+
+	if err := VerifyBatchAgainstAddresses(infos, hashes[:3], expected); err == nil {
+		t.Errorf("Expected error for mismatched slice lengths")
+	}
+}
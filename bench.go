@@ -0,0 +1,261 @@
+package eip7980
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Benchmark modes accepted by RunBench
+const (
+	BenchModeSingle   = "single"   // package-level Verify
+	BenchModeVerifier = "verifier" // one allocation-free Verifier per worker
+	BenchModeBatch    = "batch"    // VerifyBatch over the whole workload per call
+)
+
+// benchWorkloadSize is the number of distinct signed inputs each run cycles through
+const benchWorkloadSize = 64
+
+// benchSampleCap bounds the latency samples kept per worker so the
+// measurement phase does not allocate
+const benchSampleCap = 1 << 16
+
+// BenchConfig configures a RunBench capacity-planning run
+type BenchConfig struct {
+	Duration time.Duration // Measurement duration
+	Warmup   time.Duration // Warm-up duration before measuring; defaults to Duration/10
+	Workers  int           // Concurrent workers; defaults to runtime.NumCPU(). GOMAXPROCS is pinned to this value for the run
+	Mode     string        // One of the BenchMode constants; defaults to BenchModeSingle
+}
+
+// BenchReport is the machine-readable result of RunBench
+type BenchReport struct {
+	Mode        string        `json:"mode"`
+	Workers     int           `json:"workers"`
+	GOMAXPROCS  int           `json:"gomaxprocs"`
+	NumCPU      int           `json:"numCPU"`
+	Duration    time.Duration `json:"durationNs"`
+	Ops         uint64        `json:"ops"`
+	OpsPerSec   float64       `json:"opsPerSec"`
+	P50         time.Duration `json:"p50Ns"` // Per-signature latency, or per VerifyBatch call in BenchModeBatch
+	P99         time.Duration `json:"p99Ns"`
+	AllocsPerOp float64       `json:"allocsPerOp"`
+	Backend     string        `json:"backend,omitempty"`  // Backend name; set by CompareBackends only
+	Diverged    int           `json:"diverged,omitempty"` // Decisions that differ from StdlibBackend; set by CompareBackends only
+}
+
+// benchWorkload is a set of valid inputs shared read-only by all workers
+type benchWorkload struct {
+	signatureInfos [][]byte
+	payloadHashes  [][32]byte
+}
+
+// newBenchWorkload returns benchWorkloadSize valid inputs
+func newBenchWorkload() (*benchWorkload, error) {
+	return newBenchWorkloadSize(benchWorkloadSize)
+}
+
+// newBenchWorkloadSize takes size valid items, each from a different key, from a Generator
+func newBenchWorkloadSize(size int) (*benchWorkload, error) {
+	generator, err := NewGenerator(GeneratorConfig{Keys: size, KeyReuse: KeyReuseSequential})
+	if err != nil {
+		return nil, err
+	}
+
+	workload := &benchWorkload{
+		signatureInfos: make([][]byte, size),
+		payloadHashes:  make([][32]byte, size),
+	}
+	for i, item := range generator.Items(size) {
+		workload.signatureInfos[i] = item.SignatureInfo
+		workload.payloadHashes[i] = item.PayloadHash
+	}
+
+	return workload, nil
+}
+
+// benchStep performs iteration i of a worker and returns the number of signatures it verified
+type benchStep func(i int) uint64
+
+// verifyStep returns a benchStep that checks one workload input per iteration with verify
+func verifyStep(workload *benchWorkload, verify func([]byte, [32]byte) (ExecutionAddress, error)) benchStep {
+	return func(i int) uint64 {
+		j := i % len(workload.signatureInfos)
+		_, _ = verify(workload.signatureInfos[j], workload.payloadHashes[j])
+		return 1
+	}
+}
+
+// batchStep returns a benchStep that passes the whole workload to VerifyBatch per iteration
+func batchStep(workload *benchWorkload) benchStep {
+	items := make([]BatchItem, len(workload.signatureInfos))
+	for i := range items {
+		items[i] = BatchItem{SignatureInfo: workload.signatureInfos[i], PayloadHash: workload.payloadHashes[i]}
+	}
+	return func(int) uint64 {
+		_, _ = VerifyBatch(items)
+		return uint64(len(items))
+	}
+}
+
+// benchStepFunc returns the step a worker uses for mode
+func benchStepFunc(mode string, workload *benchWorkload) (benchStep, error) {
+	switch mode {
+	case BenchModeSingle:
+		return verifyStep(workload, Verify), nil
+	case BenchModeVerifier:
+		return verifyStep(workload, NewVerifier().Verify), nil
+	case BenchModeBatch:
+		return batchStep(workload), nil
+	default:
+		return nil, fmt.Errorf("unsupported bench mode %q", mode)
+	}
+}
+
+// benchWorker is the per-goroutine state of a run
+type benchWorker struct {
+	step    benchStep
+	ops     uint64
+	calls   uint64
+	samples []time.Duration
+}
+
+// run steps through the workload until deadline, recording latencies when record is set
+func (w *benchWorker) run(deadline time.Time, record bool) {
+	for i := 0; ; i++ {
+		start := time.Now()
+		if !start.Before(deadline) {
+			return
+		}
+
+		n := w.step(i)
+
+		if record {
+			elapsed := time.Since(start)
+			if len(w.samples) < cap(w.samples) {
+				w.samples = append(w.samples, elapsed)
+			} else {
+				w.samples[w.calls%uint64(len(w.samples))] = elapsed
+			}
+			w.calls++
+			w.ops += n
+		}
+	}
+}
+
+// RunBench measures verification throughput and latency for capacity planning
+//
+// A warm-up phase runs first and is excluded from the report. GOMAXPROCS is
+// set to the number of workers for the duration of the run and restored
+// afterwards. Latency percentiles are computed from up to 65536 samples per
+// worker; allocations are measured across the whole measurement phase.
+//
+// In BenchModeBatch each call verifies the whole workload, so Ops and
+// AllocsPerOp still count signatures but P50 and P99 are per call.
+func RunBench(cfg BenchConfig) (BenchReport, error) {
+	if cfg.Mode == "" {
+		cfg.Mode = BenchModeSingle
+	}
+
+	workload, err := newBenchWorkload()
+	if err != nil {
+		return BenchReport{}, err
+	}
+	if _, err := benchStepFunc(cfg.Mode, workload); err != nil {
+		return BenchReport{}, err
+	}
+
+	return runBench(cfg, func() (benchStep, error) {
+		return benchStepFunc(cfg.Mode, workload)
+	})
+}
+
+// runBench measures with one step function per worker from newStep
+func runBench(cfg BenchConfig, newStep func() (benchStep, error)) (BenchReport, error) {
+	if cfg.Duration <= 0 {
+		return BenchReport{}, fmt.Errorf("invalid bench duration %v", cfg.Duration)
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = runtime.NumCPU()
+	}
+	if cfg.Warmup <= 0 {
+		cfg.Warmup = cfg.Duration / 10
+	}
+
+	workers := make([]*benchWorker, cfg.Workers)
+	for i := range workers {
+		step, err := newStep()
+		if err != nil {
+			return BenchReport{}, err
+		}
+		workers[i] = &benchWorker{
+			step:    step,
+			samples: make([]time.Duration, 0, benchSampleCap),
+		}
+	}
+
+	previous := runtime.GOMAXPROCS(cfg.Workers)
+	defer runtime.GOMAXPROCS(previous)
+
+	phase := func(d time.Duration, record bool) time.Duration {
+		var wg sync.WaitGroup
+		start := time.Now()
+		deadline := start.Add(d)
+		for _, w := range workers {
+			wg.Add(1)
+			go func(w *benchWorker) {
+				defer wg.Done()
+				w.run(deadline, record)
+			}(w)
+		}
+		wg.Wait()
+		return time.Since(start)
+	}
+
+	phase(cfg.Warmup, false)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	elapsed := phase(cfg.Duration, true)
+	runtime.ReadMemStats(&after)
+
+	report := BenchReport{
+		Mode:       cfg.Mode,
+		Workers:    cfg.Workers,
+		GOMAXPROCS: cfg.Workers,
+		NumCPU:     runtime.NumCPU(),
+		Duration:   elapsed,
+	}
+
+	var samples []time.Duration
+	for _, w := range workers {
+		report.Ops += w.ops
+		samples = append(samples, w.samples...)
+	}
+	if report.Ops == 0 {
+		return report, nil
+	}
+
+	report.OpsPerSec = float64(report.Ops) / elapsed.Seconds()
+	report.AllocsPerOp = float64(after.Mallocs-before.Mallocs) / float64(report.Ops)
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	report.P50 = percentile(samples, 50)
+	report.P99 = percentile(samples, 99)
+
+	return report, nil
+}
+
+// percentile returns the p-th percentile of sorted samples using nearest rank
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,148 @@
+package eip7980
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestRunBench runs a short benchmark and sanity-checks the report
+func TestRunBench(t *testing.T) {
+	for _, mode := range []string{BenchModeSingle, BenchModeVerifier, BenchModeBatch} {
+		report, err := RunBench(BenchConfig{
+			Duration: 100 * time.Millisecond,
+			Workers:  2,
+			Mode:     mode,
+		})
+		if err != nil {
+			t.Fatalf("%s: RunBench failed: %v", mode, err)
+		}
+
+		if report.Mode != mode || report.Workers != 2 || report.GOMAXPROCS != 2 {
+			t.Errorf("%s: unexpected config echo %+v", mode, report)
+		}
+		if report.Ops == 0 || report.OpsPerSec <= 0 {
+			t.Errorf("%s: no operations recorded: %+v", mode, report)
+		}
+		if report.P50 <= 0 || report.P99 < report.P50 {
+			t.Errorf("%s: invalid percentiles p50=%v p99=%v", mode, report.P50, report.P99)
+		}
+		if report.NumCPU <= 0 || report.Duration < 100*time.Millisecond {
+			t.Errorf("%s: invalid report %+v", mode, report)
+		}
+	}
+}
+
+// TestVerifyStepWorkloadSize tests that steps cycle through a workload of any size
+func TestVerifyStepWorkloadSize(t *testing.T) {
+	workload, err := newBenchWorkloadSize(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var failures int
+	step := verifyStep(workload, func(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+		address, err := Verify(signatureInfo, payloadHash)
+		if err != nil {
+			failures++
+		}
+		return address, err
+	})
+	for i := 0; i < 2*benchWorkloadSize; i++ {
+		if n := step(i); n != 1 {
+			t.Fatalf("Step %d verified %d signatures, want 1", i, n)
+		}
+	}
+	if failures != 0 {
+		t.Errorf("Expected every step to verify, got %d failures", failures)
+	}
+
+	if n := batchStep(workload)(0); n != 3 {
+		t.Errorf("Batch step verified %d signatures, want 3", n)
+	}
+}
+
+// TestRunBenchInvalidConfig tests rejection of unusable configurations
+func TestRunBenchInvalidConfig(t *testing.T) {
+	if _, err := RunBench(BenchConfig{Duration: 0}); err == nil {
+		t.Errorf("Expected error for zero duration")
+	}
+	if _, err := RunBench(BenchConfig{Duration: time.Millisecond, Mode: "pool"}); err == nil {
+		t.Errorf("Expected error for unsupported mode")
+	}
+}
+
+// TestPercentile tests nearest-rank percentile selection
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i + 1)
+	}
+
+	if got := percentile(sorted, 50); got != 50 {
+		t.Errorf("p50 = %d, want 50", got)
+	}
+	if got := percentile(sorted, 99); got != 99 {
+		t.Errorf("p99 = %d, want 99", got)
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("p50 of empty = %d, want 0", got)
+	}
+}
+
+// benchReportEnv names the output path for TestBenchmarkReport; unset skips the test
+const benchReportEnv = "EIP7980_BENCH_REPORT"
+
+// benchmarkResult is one entry of the TestBenchmarkReport JSON output
+type benchmarkResult struct {
+	Name        string  `json:"name"`
+	N           int     `json:"n"`
+	NsPerOp     float64 `json:"nsPerOp"`
+	AllocsPerOp int64   `json:"allocsPerOp"`
+	BytesPerOp  int64   `json:"bytesPerOp"`
+}
+
+// TestBenchmarkReport runs the core benchmarks and writes their results as JSON for CI
+//
+// Opt in with EIP7980_BENCH_REPORT=path/to/report.json go test -run TestBenchmarkReport.
+func TestBenchmarkReport(t *testing.T) {
+	path := os.Getenv(benchReportEnv)
+	if path == "" {
+		t.Skipf("set %s to a file path to run", benchReportEnv)
+	}
+
+	benchmarks := []struct {
+		name string
+		fn   func(*testing.B)
+	}{
+		{"VerifyFullDerivation", BenchmarkVerifyFullDerivation},
+		{"VerifyKeyOnly", BenchmarkVerifyKeyOnly},
+		{"VerifierVerify", BenchmarkVerifierVerify},
+		{"DecodeIndividual", BenchmarkDecodeIndividual},
+		{"DecodeArena", BenchmarkDecodeArena},
+	}
+
+	results := make([]benchmarkResult, 0, len(benchmarks))
+	for _, bench := range benchmarks {
+		result := testing.Benchmark(bench.fn)
+		if result.N == 0 {
+			t.Fatalf("Benchmark %s did not run", bench.name)
+		}
+		results = append(results, benchmarkResult{
+			Name:        bench.name,
+			N:           result.N,
+			NsPerOp:     float64(result.T.Nanoseconds()) / float64(result.N),
+			AllocsPerOp: result.AllocsPerOp(),
+			BytesPerOp:  result.AllocedBytesPerOp(),
+		})
+	}
+
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
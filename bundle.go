@@ -0,0 +1,220 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Key bundle layout (all integers big-endian):
+//
+//	magic   [4]byte  "E7KB"
+//	version uint8    bundleVersion
+//	time    uint32   argon2id passes
+//	memory  uint32   argon2id memory in KiB
+//	threads uint8    argon2id parallelism
+//	salt    [16]byte
+//	check   [8]byte  password check value
+//	nonce   [24]byte XChaCha20-Poly1305 nonce
+//	ciphertext       sealed entries, with the header above as associated data
+//
+// Sealed entries: count uint32, then per entry a uint16 label length, the
+// label, the 32-byte seed and the 20-byte derived address.
+const (
+	bundleMagic      = "E7KB"
+	bundleVersion    = 1
+	bundleSaltSize   = 16
+	bundleCheckSize  = 8
+	bundleHeaderSize = 4 + 1 + 4 + 4 + 1 + bundleSaltSize + bundleCheckSize + chacha20poly1305.NonceSizeX
+)
+
+// Default argon2id parameters (RFC 9106 second recommended option)
+var (
+	bundleArgonTime    uint32 = 3
+	bundleArgonMemory  uint32 = 64 * 1024
+	bundleArgonThreads uint8  = 4
+)
+
+// Largest argon2id parameters ImportBundle accepts, four times the defaults
+//
+// The parameters come from the bundle header, so they are untrusted input;
+// without a cap a crafted header could make ImportBundle allocate gigabytes
+// or run for minutes before the password is even checked.
+const (
+	bundleMaxArgonTime    = 4 * 3
+	bundleMaxArgonMemory  = 4 * 64 * 1024
+	bundleMaxArgonThreads = 4 * 4
+)
+
+// Errors returned by ImportBundle
+var (
+	ErrWrongPassword            = errors.New("wrong bundle password")
+	ErrBundleCorrupted          = errors.New("key bundle is corrupted")
+	ErrUnsupportedBundleVersion = errors.New("unsupported key bundle version")
+)
+
+// bundleKeys derives the encryption key and password check value
+func bundleKeys(password string, salt []byte, passes, memory uint32, threads uint8) (key []byte, check [bundleCheckSize]byte) {
+	derived := argon2.IDKey([]byte(password), salt, passes, memory, threads, chacha20poly1305.KeySize+32)
+
+	sum := sha256.Sum256(derived[chacha20poly1305.KeySize:])
+	copy(check[:], sum[:])
+	return derived[:chacha20poly1305.KeySize], check
+}
+
+// ExportBundle encrypts keys into a single portable bundle
+//
+// The bundle is protected with an argon2id-derived key and
+// XChaCha20-Poly1305; labels and derived addresses are stored alongside each
+// seed so ImportBundle can check every entry. An empty key list produces a
+// valid empty bundle.
+func ExportBundle(keys []LabeledKey, password string) ([]byte, error) {
+	var plaintext bytes.Buffer
+	binary.Write(&plaintext, binary.BigEndian, uint32(len(keys)))
+
+	for i, key := range keys {
+		if len(key.PrivateKey) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("key %d: invalid private key length %d", i, len(key.PrivateKey))
+		}
+		if len(key.Label) > math.MaxUint16 {
+			return nil, fmt.Errorf("key %d: label too long", i)
+		}
+
+		address := deriveAddress(key.PrivateKey[ed25519.SeedSize:])
+		binary.Write(&plaintext, binary.BigEndian, uint16(len(key.Label)))
+		plaintext.WriteString(key.Label)
+		plaintext.Write(key.PrivateKey.Seed())
+		plaintext.Write(address[:])
+	}
+
+	header := make([]byte, 0, bundleHeaderSize)
+	header = append(header, bundleMagic...)
+	header = append(header, bundleVersion)
+	header = binary.BigEndian.AppendUint32(header, bundleArgonTime)
+	header = binary.BigEndian.AppendUint32(header, bundleArgonMemory)
+	header = append(header, bundleArgonThreads)
+
+	salt := make([]byte, bundleSaltSize)
+	nonce := make([]byte, chacha20poly1305.NonceSizeX)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	key, check := bundleKeys(password, salt, bundleArgonTime, bundleArgonMemory, bundleArgonThreads)
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header = append(header, salt...)
+	header = append(header, check[:]...)
+	header = append(header, nonce...)
+
+	return aead.Seal(header, nonce, plaintext.Bytes(), header), nil
+}
+
+// ImportBundle decrypts a bundle produced by ExportBundle
+//
+// Returns ErrWrongPassword if the password does not match, and
+// ErrBundleCorrupted if the bundle was modified or an entry's stored address
+// does not match its key. Bundles whose key derivation parameters exceed four
+// times the export defaults are rejected before any key is derived.
+func ImportBundle(data []byte, password string) ([]LabeledKey, error) {
+	if len(data) < bundleHeaderSize+chacha20poly1305.Overhead || string(data[:4]) != bundleMagic {
+		return nil, ErrBundleCorrupted
+	}
+	if data[4] != bundleVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedBundleVersion, data[4])
+	}
+
+	passes := binary.BigEndian.Uint32(data[5:9])
+	memory := binary.BigEndian.Uint32(data[9:13])
+	threads := data[13]
+	if passes == 0 || threads == 0 || memory < 8*uint32(threads) {
+		return nil, fmt.Errorf("%w: invalid key derivation parameters", ErrBundleCorrupted)
+	}
+	if passes > bundleMaxArgonTime || memory > bundleMaxArgonMemory || threads > bundleMaxArgonThreads {
+		return nil, fmt.Errorf("%w: key derivation parameters exceed the limit (time %d, memory %d KiB, threads %d)",
+			ErrBundleCorrupted, passes, memory, threads)
+	}
+
+	offset := 14
+	salt := data[offset : offset+bundleSaltSize]
+	offset += bundleSaltSize
+	storedCheck := data[offset : offset+bundleCheckSize]
+	offset += bundleCheckSize
+	nonce := data[offset : offset+chacha20poly1305.NonceSizeX]
+
+	header := data[:bundleHeaderSize]
+	key, check := bundleKeys(password, salt, passes, memory, threads)
+	if subtle.ConstantTimeCompare(check[:], storedCheck) != 1 {
+		return nil, ErrWrongPassword
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, data[bundleHeaderSize:], header)
+	if err != nil {
+		return nil, ErrBundleCorrupted
+	}
+
+	return decodeBundleEntries(plaintext)
+}
+
+// decodeBundleEntries parses decrypted entries and checks each stored address
+func decodeBundleEntries(plaintext []byte) ([]LabeledKey, error) {
+	if len(plaintext) < 4 {
+		return nil, ErrBundleCorrupted
+	}
+	count := binary.BigEndian.Uint32(plaintext)
+	rest := plaintext[4:]
+
+	// Each entry takes at least 2+32+20 bytes, which bounds the allocation
+	if uint64(count)*(2+ed25519.SeedSize+20) > uint64(len(rest)) {
+		return nil, ErrBundleCorrupted
+	}
+
+	keys := make([]LabeledKey, 0, count)
+	for i := uint32(0); i < count; i++ {
+		if len(rest) < 2 {
+			return nil, fmt.Errorf("%w: entry %d truncated", ErrBundleCorrupted, i)
+		}
+		labelLen := int(binary.BigEndian.Uint16(rest))
+		rest = rest[2:]
+		if len(rest) < labelLen+ed25519.SeedSize+20 {
+			return nil, fmt.Errorf("%w: entry %d truncated", ErrBundleCorrupted, i)
+		}
+
+		label := string(rest[:labelLen])
+		rest = rest[labelLen:]
+		privateKey := ed25519.NewKeyFromSeed(rest[:ed25519.SeedSize])
+		rest = rest[ed25519.SeedSize:]
+
+		key, _ := NewLabeledKey(label, privateKey)
+		if !bytes.Equal(key.Address[:], rest[:20]) {
+			return nil, fmt.Errorf("%w: entry %d address does not match its key", ErrBundleCorrupted, i)
+		}
+		rest = rest[20:]
+
+		keys = append(keys, key)
+	}
+
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing data", ErrBundleCorrupted)
+	}
+	return keys, nil
+}
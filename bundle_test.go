@@ -0,0 +1,146 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+// useFastBundleKDF lowers argon2id cost for the duration of a test
+func useFastBundleKDF(t *testing.T) {
+	t.Helper()
+
+	time, memory, threads := bundleArgonTime, bundleArgonMemory, bundleArgonThreads
+	bundleArgonTime, bundleArgonMemory, bundleArgonThreads = 1, 64, 1
+	t.Cleanup(func() {
+		bundleArgonTime, bundleArgonMemory, bundleArgonThreads = time, memory, threads
+	})
+}
+
+// newTestLabeledKeys generates n labeled keys
+func newTestLabeledKeys(t *testing.T, n int) []LabeledKey {
+	t.Helper()
+
+	keys := make([]LabeledKey, n)
+	for i := range keys {
+		_, privateKey, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i], err = NewLabeledKey(string(rune('a'+i))+"-signer", privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+// TestBundleRoundTrip tests exporting and importing several keys
+func TestBundleRoundTrip(t *testing.T) {
+	useFastBundleKDF(t)
+	keys := newTestLabeledKeys(t, 3)
+
+	data, err := ExportBundle(keys, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := ImportBundle(data, "correct horse")
+	if err != nil {
+		t.Fatalf("ImportBundle failed: %v", err)
+	}
+	if len(imported) != len(keys) {
+		t.Fatalf("Imported %d keys, want %d", len(imported), len(keys))
+	}
+	for i := range keys {
+		if imported[i].Label != keys[i].Label || !imported[i].PrivateKey.Equal(keys[i].PrivateKey) || imported[i].Address != keys[i].Address {
+			t.Errorf("Key %d changed in round trip", i)
+		}
+	}
+}
+
+// TestBundleEmpty tests that an empty key list round-trips
+func TestBundleEmpty(t *testing.T) {
+	useFastBundleKDF(t)
+
+	data, err := ExportBundle(nil, "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ImportBundle(data, "pw")
+	if err != nil || len(keys) != 0 {
+		t.Errorf("Empty bundle: got %d keys, err %v", len(keys), err)
+	}
+}
+
+// TestBundleWrongPassword tests wrong-password detection
+func TestBundleWrongPassword(t *testing.T) {
+	useFastBundleKDF(t)
+
+	data, _ := ExportBundle(newTestLabeledKeys(t, 1), "right")
+	if _, err := ImportBundle(data, "wrong"); err != ErrWrongPassword {
+		t.Errorf("Expected ErrWrongPassword, got %v", err)
+	}
+}
+
+// TestBundleTampered tests that modified ciphertext and headers are rejected
+func TestBundleTampered(t *testing.T) {
+	useFastBundleKDF(t)
+
+	data, _ := ExportBundle(newTestLabeledKeys(t, 2), "pw")
+
+	ciphertext := append([]byte{}, data...)
+	ciphertext[len(ciphertext)-20] ^= 0x01
+	if _, err := ImportBundle(ciphertext, "pw"); !errors.Is(err, ErrBundleCorrupted) {
+		t.Errorf("Tampered ciphertext: expected ErrBundleCorrupted, got %v", err)
+	}
+
+	nonce := append([]byte{}, data...)
+	nonce[bundleHeaderSize-1] ^= 0x01
+	if _, err := ImportBundle(nonce, "pw"); !errors.Is(err, ErrBundleCorrupted) {
+		t.Errorf("Tampered header: expected ErrBundleCorrupted, got %v", err)
+	}
+
+	version := append([]byte{}, data...)
+	version[4] = bundleVersion + 1
+	if _, err := ImportBundle(version, "pw"); !errors.Is(err, ErrUnsupportedBundleVersion) {
+		t.Errorf("Future version: expected ErrUnsupportedBundleVersion, got %v", err)
+	}
+
+	if _, err := ImportBundle(data[:bundleHeaderSize], "pw"); !errors.Is(err, ErrBundleCorrupted) {
+		t.Errorf("Truncated bundle: expected ErrBundleCorrupted, got %v", err)
+	}
+}
+
+// TestBundleKDFLimits tests that oversized argon2id parameters are rejected before deriving a key
+func TestBundleKDFLimits(t *testing.T) {
+	useFastBundleKDF(t)
+
+	data, _ := ExportBundle(newTestLabeledKeys(t, 1), "pw")
+
+	cases := []struct {
+		name   string
+		offset int
+		value  []byte
+	}{
+		{"time", 5, []byte{0, 0, 0, bundleMaxArgonTime + 1}},
+		{"memory", 9, binary.BigEndian.AppendUint32(nil, bundleMaxArgonMemory+1)},
+		{"memory max", 9, []byte{0xff, 0xff, 0xff, 0xff}},
+		{"threads", 13, []byte{bundleMaxArgonThreads + 1}},
+	}
+	for _, tc := range cases {
+		oversized := append([]byte{}, data...)
+		copy(oversized[tc.offset:], tc.value)
+
+		start := time.Now()
+		_, err := ImportBundle(oversized, "pw")
+		if !errors.Is(err, ErrBundleCorrupted) {
+			t.Errorf("%s: expected ErrBundleCorrupted, got %v", tc.name, err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("%s: rejection took %v, expected it before key derivation", tc.name, elapsed)
+		}
+	}
+}
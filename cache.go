@@ -0,0 +1,267 @@
+package eip7980
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// DEFAULT_VERIFY_CACHE_SIZE is the most results a VerifyCache holds before
+// evicting the least recently used one
+const DEFAULT_VERIFY_CACHE_SIZE = 1 << 16
+
+// verifyCacheKey identifies one verification by its exact inputs
+type verifyCacheKey struct {
+	signatureInfo [MAX_SIZE]byte
+	payloadHash   [32]byte
+}
+
+// verifyCacheEntry is a cached verification outcome and the earliest block it was seen in
+type verifyCacheEntry struct {
+	key      verifyCacheKey
+	inputs   verifyCacheKey // Exact inputs, checked on hit; only set under WithKeyFunc
+	address  ExecutionAddress
+	err      error
+	blockNum uint64
+	expires  time.Time // zero when the cache has no TTL
+}
+
+// VerifyCacheOption configures a VerifyCache created by NewVerifyCache
+type VerifyCacheOption func(*VerifyCache)
+
+// WithTTL expires entries ttl after they are stored; zero or negative means no expiry
+//
+// Expired entries are dropped lazily on read and by a background sweeper
+// that runs every ttl until Close is called.
+func WithTTL(ttl time.Duration) VerifyCacheOption {
+	return func(c *VerifyCache) { c.ttl = max(ttl, 0) }
+}
+
+// WithNegativeCaching caches failed verifications as well as successes
+//
+// Off by default: failures are keyed by input anyone can vary for free, so
+// caching them lets a caller churn the cache with junk. Enable it only when
+// the inputs come from a source that is already rate limited.
+func WithNegativeCaching() VerifyCacheOption {
+	return func(c *VerifyCache) { c.cacheFailures = true }
+}
+
+// WithMaxEntries caps the cache at n results, evicting the least recently
+// used; zero or negative means DEFAULT_VERIFY_CACHE_SIZE
+func WithMaxEntries(n int) VerifyCacheOption {
+	return func(c *VerifyCache) { c.maxEntries = max(n, 0) }
+}
+
+// WithKeyFunc keys entries by keyFunc's result, such as a transaction hash,
+// instead of by the exact signatureInfo and payloadHash
+//
+// Each entry still stores the exact inputs it was verified with, and a
+// lookup only hits when they match, so a forged signatureInfo under a known
+// key is verified rather than served the cached address. A different input
+// under the same key replaces the entry if it verifies.
+func WithKeyFunc(keyFunc func(signatureInfo []byte, payloadHash [32]byte) [32]byte) VerifyCacheOption {
+	return func(c *VerifyCache) { c.keyFunc = keyFunc }
+}
+
+// VerifyCache memoizes verification results tagged with the block they were computed in
+//
+// Successful verifications are cached; failures only with
+// WithNegativeCaching. At most DEFAULT_VERIFY_CACHE_SIZE results are kept
+// (see WithMaxEntries), evicting the least recently used. Each entry remembers the lowest block
+// number it was verified at; InvalidateFrom drops everything first seen at or
+// after a reorged block. VerifyCache is safe for concurrent use. The zero
+// value is an empty cache without expiry; a nil *VerifyCache verifies without
+// caching.
+type VerifyCache struct {
+	mu      sync.Mutex
+	entries map[verifyCacheKey]*list.Element // Values are *verifyCacheEntry
+	order   list.List                        // Most recently used at the front
+
+	cacheFailures bool
+	maxEntries    int // See WithMaxEntries
+	ttl           time.Duration
+	keyFunc       func(signatureInfo []byte, payloadHash [32]byte) [32]byte
+	now           func() time.Time // replaced in tests
+
+	closeOnce sync.Once
+	stop      chan struct{} // closed by Close; nil without a sweeper
+	done      chan struct{} // closed when the sweeper exits
+}
+
+// NewVerifyCache creates an empty cache
+//
+// With WithTTL, the cache starts a sweeper goroutine; call Close to stop it.
+func NewVerifyCache(opts ...VerifyCacheOption) *VerifyCache {
+	c := &VerifyCache{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.ttl > 0 {
+		c.stop = make(chan struct{})
+		c.done = make(chan struct{})
+		go c.sweep()
+	}
+	return c
+}
+
+// Close stops the background sweeper, if any; the cache stays usable
+func (c *VerifyCache) Close() {
+	if c == nil || c.stop == nil {
+		return
+	}
+	c.closeOnce.Do(func() { close(c.stop) })
+	<-c.done
+}
+
+// sweep drops expired entries every ttl until Close
+func (c *VerifyCache) sweep() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.ttl)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.removeExpired()
+		}
+	}
+}
+
+// removeExpired deletes every entry past its expiry
+func (c *VerifyCache) removeExpired() {
+	now := c.clock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeWhere(func(entry *verifyCacheEntry) bool { return entry.expired(now) })
+}
+
+// removeWhere deletes every entry matching drop; the caller holds c.mu
+func (c *VerifyCache) removeWhere(drop func(*verifyCacheEntry) bool) {
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if entry := element.Value.(*verifyCacheEntry); drop(entry) {
+			c.order.Remove(element)
+			delete(c.entries, entry.key)
+		}
+		element = next
+	}
+}
+
+// clock returns the current time from the cache's clock
+func (c *VerifyCache) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// expired reports whether the entry has a TTL that has passed at now
+func (e *verifyCacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// key returns the map key for one verification under the cache's keying
+// scheme, and the exact inputs to store and compare when they differ from it
+func (c *VerifyCache) key(signatureInfo []byte, payloadHash [32]byte) (key, inputs verifyCacheKey) {
+	inputs = verifyCacheKey{payloadHash: payloadHash}
+	copy(inputs.signatureInfo[:], signatureInfo)
+	if c.keyFunc == nil {
+		return inputs, verifyCacheKey{}
+	}
+	return verifyCacheKey{payloadHash: c.keyFunc(signatureInfo, payloadHash)}, inputs
+}
+
+// VerifyAt verifies signatureInfo against payloadHash in the context of block blockNum
+//
+// Malformed-length input is never cached and is rejected exactly as Verify
+// rejects it.
+func (c *VerifyCache) VerifyAt(blockNum uint64, signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	if c == nil || len(signatureInfo) != MAX_SIZE {
+		return Verify(signatureInfo, payloadHash)
+	}
+
+	key, inputs := c.key(signatureInfo, payloadHash)
+	now := c.clock()
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*verifyCacheEntry)
+		if entry.inputs == inputs && entry.blockNum <= blockNum && !entry.expired(now) {
+			c.order.MoveToFront(element)
+			address, err := entry.address, entry.err
+			c.mu.Unlock()
+			return address, err
+		}
+	}
+	c.mu.Unlock()
+
+	address, err := Verify(signatureInfo, payloadHash)
+	if err != nil && !c.cacheFailures {
+		return address, err
+	}
+
+	var expires time.Time
+	if c.ttl > 0 {
+		expires = now.Add(c.ttl)
+	}
+	c.store(&verifyCacheEntry{key: key, inputs: inputs, address: address, err: err, blockNum: blockNum, expires: expires}, now)
+	return address, err
+}
+
+// store inserts entry unless a live entry from an earlier block exists,
+// evicting the least recently used entry when the cache is full
+func (c *VerifyCache) store(entry *verifyCacheEntry, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[verifyCacheKey]*list.Element)
+	}
+	if element, ok := c.entries[entry.key]; ok {
+		existing := element.Value.(*verifyCacheEntry)
+		if existing.inputs == entry.inputs && existing.blockNum <= entry.blockNum && !existing.expired(now) {
+			c.order.MoveToFront(element)
+			return
+		}
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	limit := c.maxEntries
+	if limit <= 0 {
+		limit = DEFAULT_VERIFY_CACHE_SIZE
+	}
+	for len(c.entries) >= limit {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*verifyCacheEntry).key)
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+}
+
+// InvalidateFrom drops every entry first verified at block blockNum or later
+func (c *VerifyCache) InvalidateFrom(blockNum uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeWhere(func(entry *verifyCacheEntry) bool { return entry.blockNum >= blockNum })
+}
+
+// Len returns the number of cached results
+func (c *VerifyCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
@@ -0,0 +1,240 @@
+package eip7980
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestVerifyCacheReorg simulates a reorg dropping entries from the abandoned blocks
+func TestVerifyCacheReorg(t *testing.T) {
+	cache := NewVerifyCache()
+
+	var infos [][]byte
+	var hashes [][32]byte
+	for block := uint64(10); block < 14; block++ {
+		payloadHash := [32]byte{byte(block)}
+		signatureInfo := newTestSignatureInfo(t, payloadHash)
+		infos = append(infos, signatureInfo)
+		hashes = append(hashes, payloadHash)
+
+		want, _ := Verify(signatureInfo, payloadHash)
+		got, err := cache.VerifyAt(block, signatureInfo, payloadHash)
+		if err != nil || got != want {
+			t.Fatalf("Block %d: expected %s, got %s, %v", block, want, got, err)
+		}
+	}
+
+	// Re-verifying the block 10 entry at block 13 must keep its earliest block
+	if _, err := cache.VerifyAt(13, infos[0], hashes[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.InvalidateFrom(12)
+	if cache.Len() != 2 {
+		t.Errorf("Expected 2 entries after reorg at block 12, got %d", cache.Len())
+	}
+
+	cache.InvalidateFrom(10)
+	if cache.Len() != 0 {
+		t.Errorf("Expected empty cache after reorg at block 10, got %d", cache.Len())
+	}
+}
+
+// TestVerifyCacheFailures tests that failures are only cached on request and malformed input never is
+func TestVerifyCacheFailures(t *testing.T) {
+	payloadHash := [32]byte{1}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	signatureInfo[0] ^= 0xff
+
+	for _, tc := range []struct {
+		name string
+		opts []VerifyCacheOption
+		want int
+	}{
+		{"default", nil, 0},
+		{"negative caching", []VerifyCacheOption{WithNegativeCaching()}, 1},
+	} {
+		cache := NewVerifyCache(tc.opts...)
+		for block := uint64(1); block <= 2; block++ {
+			if _, err := cache.VerifyAt(block, signatureInfo, payloadHash); !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("%s: expected ErrInvalidSignature, got %v", tc.name, err)
+			}
+		}
+		if _, err := cache.VerifyAt(1, make([]byte, 95), payloadHash); err == nil {
+			t.Errorf("%s: expected error for short input", tc.name)
+		}
+		if cache.Len() != tc.want {
+			t.Errorf("%s: expected %d entries, got %d", tc.name, tc.want, cache.Len())
+		}
+	}
+}
+
+// TestVerifyCacheEviction tests that a full cache evicts the least recently used entry
+func TestVerifyCacheEviction(t *testing.T) {
+	cache := NewVerifyCache(WithMaxEntries(2))
+
+	vectors := DeterministicVectors(3)
+	verify := func(i int) {
+		t.Helper()
+		if _, err := cache.VerifyAt(1, vectors[i].SignatureInfo(), vectors[i].PayloadHash); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cached := func(i int) bool {
+		key, _ := cache.key(vectors[i].SignatureInfo(), vectors[i].PayloadHash)
+		_, ok := cache.entries[key]
+		return ok
+	}
+
+	verify(0)
+	verify(1)
+	verify(0) // 1 is now least recently used
+	verify(2)
+
+	if cache.Len() != 2 || !cached(0) || cached(1) || !cached(2) {
+		t.Errorf("Expected entries 0 and 2 after evicting 1, got len %d: %v %v %v", cache.Len(), cached(0), cached(1), cached(2))
+	}
+}
+
+// withClock makes a VerifyCache read time from now; applied before any sweeper starts
+func withClock(now func() time.Time) VerifyCacheOption {
+	return func(c *VerifyCache) { c.now = now }
+}
+
+// countingBackend counts signature checks so tests can tell cache hits from misses
+type countingBackend struct {
+	StdlibBackend
+	calls int
+}
+
+func (b *countingBackend) Verify(publicKey, message, signature []byte) bool {
+	b.calls++
+	return b.StdlibBackend.Verify(publicKey, message, signature)
+}
+
+// installCountingBackend routes package verification through a countingBackend for the test
+func installCountingBackend(t *testing.T) *countingBackend {
+	backend := &countingBackend{}
+	SetBackend(backend)
+	t.Cleanup(func() { SetBackend(nil) })
+	return backend
+}
+
+// TestVerifyCacheTTL tests expiry boundaries under a transaction-hash key and that a zero TTL never expires
+func TestVerifyCacheTTL(t *testing.T) {
+	payloadHash := [32]byte{0x66}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	want, _ := Verify(signatureInfo, payloadHash)
+	backend := installCountingBackend(t)
+
+	// Every call maps to the same transaction hash
+	txHash := func([]byte, [32]byte) [32]byte { return [32]byte{0x99} }
+
+	start := time.Unix(1_700_000_000, 0)
+	clock := start
+	now := func() time.Time { return clock }
+
+	const ttl = time.Hour
+	cache := NewVerifyCache(WithTTL(ttl), WithKeyFunc(txHash), withClock(now))
+	defer cache.Close()
+
+	for _, tc := range []struct {
+		name    string
+		elapsed time.Duration
+		hit     bool
+	}{
+		{"fresh", 0, true},
+		{"just before expiry", ttl - time.Nanosecond, true},
+		{"at expiry", ttl, true},
+		{"just after expiry", ttl + time.Nanosecond, false},
+	} {
+		clock = start
+		cache.InvalidateFrom(0)
+		if got, err := cache.VerifyAt(1, signatureInfo, payloadHash); err != nil || got != want {
+			t.Fatalf("%s: priming failed: %s, %v", tc.name, got, err)
+		}
+
+		clock = start.Add(tc.elapsed)
+		before := backend.calls
+		if got, err := cache.VerifyAt(1, signatureInfo, payloadHash); err != nil || got != want {
+			t.Errorf("%s: expected %s, got %s, %v", tc.name, want, got, err)
+		}
+		if hit := backend.calls == before; hit != tc.hit {
+			t.Errorf("%s: expected hit=%v", tc.name, tc.hit)
+		}
+	}
+
+	forever := NewVerifyCache(WithTTL(0), withClock(now))
+	defer forever.Close()
+	clock = start
+	forever.VerifyAt(1, signatureInfo, payloadHash)
+	clock = start.Add(100 * 365 * 24 * time.Hour)
+	forever.removeExpired()
+	if forever.Len() != 1 || forever.stop != nil {
+		t.Errorf("Expected a zero TTL to never expire and start no sweeper")
+	}
+}
+
+// TestVerifyCacheKeyFuncForgery tests that a forged signatureInfo under a cached key is verified, not served
+func TestVerifyCacheKeyFuncForgery(t *testing.T) {
+	payloadHash := [32]byte{0x67}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	forged := append([]byte(nil), signatureInfo...)
+	forged[0] ^= 0x01
+
+	txHash := func([]byte, [32]byte) [32]byte { return [32]byte{0x98} }
+	for _, opts := range [][]VerifyCacheOption{
+		{WithKeyFunc(txHash)},
+		{WithKeyFunc(txHash), WithNegativeCaching()},
+	} {
+		cache := NewVerifyCache(opts...)
+		if _, err := cache.VerifyAt(1, signatureInfo, payloadHash); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cache.VerifyAt(1, forged, payloadHash); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Expected ErrInvalidSignature for a forged input under a cached key, got %v", err)
+		}
+		if _, err := cache.VerifyAt(1, forged, [32]byte{0x01}); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Expected ErrInvalidSignature for a different payload under a cached key, got %v", err)
+		}
+	}
+}
+
+// TestVerifyCacheSweeperShutdown tests that the sweeper removes expired entries and exits on Close
+func TestVerifyCacheSweeperShutdown(t *testing.T) {
+	payloadHash := [32]byte{0x77}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	before := runtime.NumGoroutine()
+
+	cache := NewVerifyCache(WithTTL(5 * time.Millisecond))
+	cache.VerifyAt(1, signatureInfo, payloadHash)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for cache.Len() != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Sweeper did not remove the expired entry")
+	}
+
+	cache.Close()
+	cache.Close()
+	select {
+	case <-cache.done:
+	default:
+		t.Fatalf("Sweeper still running after Close")
+	}
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("Goroutine leak: %d before, %d after Close", before, after)
+	}
+
+	// The cache stays usable after Close
+	if _, err := cache.VerifyAt(1, signatureInfo, payloadHash); err != nil {
+		t.Errorf("Closed cache failed to verify: %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package eip7980
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// calibrationInputs is the number of distinct signed inputs a calibration run cycles through
+const calibrationInputs = 256
+
+// DefaultCalibrationBuckets are the default histogram bucket upper bounds
+var DefaultCalibrationBuckets = []time.Duration{
+	10 * time.Microsecond,
+	20 * time.Microsecond,
+	30 * time.Microsecond,
+	40 * time.Microsecond,
+	50 * time.Microsecond,
+	75 * time.Microsecond,
+	100 * time.Microsecond,
+	150 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+}
+
+// CalibrationConfig configures a timing calibration run
+type CalibrationConfig struct {
+	N       int             // Number of timed verifications
+	Buckets []time.Duration // Ascending bucket upper bounds; defaults to DefaultCalibrationBuckets
+
+	// Baseline, if set, is timed right after each verification and passed
+	// the sample index, giving a paired measurement of a reference operation
+	// under the same conditions. The geth module provides an ecrecover one.
+	Baseline func(i int)
+}
+
+// Histogram is a fixed-bucket distribution of verification timings
+//
+// Counts[i] holds samples in (Bounds[i-1], Bounds[i]]; the final extra
+// element of Counts holds samples above the last bound. Percentiles are
+// computed from the exact samples, not interpolated from buckets.
+type Histogram struct {
+	Bounds []time.Duration
+	Counts []uint64
+	Total  uint64
+	Min    time.Duration
+	Max    time.Duration
+	P50    time.Duration
+	P90    time.Duration
+	P99    time.Duration
+
+	Baseline *Histogram // Paired CalibrationConfig.Baseline timings, same bounds; nil without a baseline
+	Ratio    float64    // P50 / Baseline.P50; zero without a baseline
+}
+
+// newHistogram creates an empty histogram over bounds
+func newHistogram(bounds []time.Duration) (Histogram, error) {
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return Histogram{}, errors.New("histogram bounds must be strictly ascending")
+		}
+	}
+
+	return Histogram{
+		Bounds: append([]time.Duration(nil), bounds...),
+		Counts: make([]uint64, len(bounds)+1),
+	}, nil
+}
+
+// observe adds one sample to its bucket
+func (h *Histogram) observe(d time.Duration) {
+	i := sort.Search(len(h.Bounds), func(i int) bool { return d <= h.Bounds[i] })
+	h.Counts[i]++
+
+	if h.Total == 0 || d < h.Min {
+		h.Min = d
+	}
+	if d > h.Max {
+		h.Max = d
+	}
+	h.Total++
+}
+
+// CalibrationRun measures the wall-clock time of N verifications of randomized valid inputs
+//
+// It supports research into whether GAS_PENALTY reflects real verification
+// cost. Inputs are signed with fresh random keys before timing starts; only
+// the Verify call itself is timed. With cfg.Baseline set, each verification
+// is paired with one timed baseline call and Ratio compares the medians; for
+// an ecrecover baseline, Ratio times ecrecover's 3000 gas is the price that
+// matches the measured cost.
+func CalibrationRun(cfg CalibrationConfig) (Histogram, error) {
+	if cfg.N <= 0 {
+		return Histogram{}, errors.New("calibration requires N > 0")
+	}
+	buckets := cfg.Buckets
+	if buckets == nil {
+		buckets = DefaultCalibrationBuckets
+	}
+
+	histogram, err := newHistogram(buckets)
+	if err != nil {
+		return Histogram{}, err
+	}
+
+	workload, err := newBenchWorkloadSize(calibrationInputs)
+	if err != nil {
+		return Histogram{}, err
+	}
+
+	var baseline Histogram
+	var baselineSamples []time.Duration
+	if cfg.Baseline != nil {
+		baseline, _ = newHistogram(buckets)
+		baselineSamples = make([]time.Duration, cfg.N)
+	}
+
+	samples := make([]time.Duration, cfg.N)
+	for i := range samples {
+		j := i % calibrationInputs
+		start := time.Now()
+		_, _ = Verify(workload.signatureInfos[j], workload.payloadHashes[j])
+		samples[i] = time.Since(start)
+		histogram.observe(samples[i])
+
+		if cfg.Baseline != nil {
+			start = time.Now()
+			cfg.Baseline(i)
+			baselineSamples[i] = time.Since(start)
+			baseline.observe(baselineSamples[i])
+		}
+	}
+
+	histogram.summarize(samples)
+	if cfg.Baseline != nil {
+		baseline.summarize(baselineSamples)
+		histogram.Baseline = &baseline
+		if baseline.P50 > 0 {
+			histogram.Ratio = float64(histogram.P50) / float64(baseline.P50)
+		}
+	}
+
+	return histogram, nil
+}
+
+// summarize sorts samples in place and records their percentiles
+func (h *Histogram) summarize(samples []time.Duration) {
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	h.P50 = percentile(samples, 50)
+	h.P90 = percentile(samples, 90)
+	h.P99 = percentile(samples, 99)
+}
+
+// WriteCSV writes the histogram as "upper_bound_ns,count" rows, with "+Inf" for the overflow bucket
+func (h Histogram) WriteCSV(w io.Writer) error {
+	if w == nil {
+		return errNilWriter
+	}
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"upper_bound_ns", "count"})
+	for i, count := range h.Counts {
+		bound := "+Inf"
+		if i < len(h.Bounds) {
+			bound = strconv.FormatInt(h.Bounds[i].Nanoseconds(), 10)
+		}
+		writer.Write([]string{bound, strconv.FormatUint(count, 10)})
+	}
+	writer.Flush()
+	return writer.Error()
+}
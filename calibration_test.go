@@ -0,0 +1,105 @@
+package eip7980
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// TestHistogramBuckets tests bucket boundaries and the overflow bucket
+func TestHistogramBuckets(t *testing.T) {
+	histogram, err := newHistogram([]time.Duration{10, 20, 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range []time.Duration{1, 10, 11, 20, 25, 30, 31, 1000} {
+		histogram.observe(d)
+	}
+
+	want := []uint64{2, 2, 2, 2}
+	for i := range want {
+		if histogram.Counts[i] != want[i] {
+			t.Errorf("Counts = %v, want %v", histogram.Counts, want)
+			break
+		}
+	}
+	if histogram.Total != 8 || histogram.Min != 1 || histogram.Max != 1000 {
+		t.Errorf("Total=%d Min=%d Max=%d", histogram.Total, histogram.Min, histogram.Max)
+	}
+
+	if _, err := newHistogram([]time.Duration{10, 10}); err == nil {
+		t.Errorf("Expected error for non-ascending bounds")
+	}
+}
+
+// TestHistogramCSV tests CSV formatting
+func TestHistogramCSV(t *testing.T) {
+	histogram, _ := newHistogram([]time.Duration{time.Microsecond, 2 * time.Microsecond})
+	histogram.observe(500 * time.Nanosecond)
+	histogram.observe(5 * time.Microsecond)
+
+	var buf bytes.Buffer
+	if err := histogram.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "upper_bound_ns,count\n1000,1\n2000,0\n+Inf,1\n"
+	if buf.String() != want {
+		t.Errorf("CSV = %q, want %q", buf.String(), want)
+	}
+}
+
+// TestCalibrationRun runs a tiny calibration and checks the summary
+func TestCalibrationRun(t *testing.T) {
+	histogram, err := CalibrationRun(CalibrationConfig{N: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sum uint64
+	for _, count := range histogram.Counts {
+		sum += count
+	}
+	if histogram.Total != 20 || sum != 20 {
+		t.Errorf("Total = %d, bucket sum = %d, want 20", histogram.Total, sum)
+	}
+	if len(histogram.Counts) != len(DefaultCalibrationBuckets)+1 {
+		t.Errorf("Got %d buckets, want %d", len(histogram.Counts), len(DefaultCalibrationBuckets)+1)
+	}
+	if histogram.Min <= 0 || histogram.P50 < histogram.Min || histogram.P99 < histogram.P50 || histogram.Max < histogram.P99 {
+		t.Errorf("Inconsistent summary: %+v", histogram)
+	}
+
+	if histogram.Baseline != nil || histogram.Ratio != 0 {
+		t.Errorf("Expected no baseline, got %+v, ratio %v", histogram.Baseline, histogram.Ratio)
+	}
+
+	if _, err := CalibrationRun(CalibrationConfig{N: 0}); err == nil {
+		t.Errorf("Expected error for N = 0")
+	}
+}
+
+// TestCalibrationRunBaseline tests that a baseline is paired with every verification
+func TestCalibrationRunBaseline(t *testing.T) {
+	var calls []int
+	histogram, err := CalibrationRun(CalibrationConfig{N: 20, Baseline: func(i int) {
+		calls = append(calls, i)
+		time.Sleep(time.Microsecond)
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, call := range calls {
+		if call != i {
+			t.Fatalf("Baseline calls = %v, want 0..19 in order", calls)
+		}
+	}
+	if baseline := histogram.Baseline; len(calls) != 20 || baseline == nil || baseline.Total != 20 || baseline.P50 < time.Microsecond {
+		t.Fatalf("Unexpected baseline %+v after %d calls", baseline, len(calls))
+	}
+	if want := float64(histogram.P50) / float64(histogram.Baseline.P50); histogram.Ratio != want {
+		t.Errorf("Ratio = %v, want %v", histogram.Ratio, want)
+	}
+}
@@ -0,0 +1,317 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// ErrNotCanonicalizable is returned for values RFC 8785 cannot represent, such as NaN, channels or duplicate keys
+var ErrNotCanonicalizable = errors.New("value cannot be canonicalized as JSON")
+
+// CanonicalJSON serializes v with the RFC 8785 JSON Canonicalization Scheme (JCS)
+//
+// v is first marshaled with encoding/json, so struct tags and Marshaler
+// implementations apply and json.RawMessage passes through. The result is
+// then rewritten with object keys sorted by UTF-16 code units, no
+// insignificant whitespace, the JCS string escapes, and numbers formatted
+// like ECMAScript's Number.prototype.toString. Numbers are IEEE 754 doubles,
+// as I-JSON requires, so integers beyond 2^53 lose precision.
+//
+// Strings must be valid Unicode: invalid UTF-8 and lone surrogate escapes
+// are rejected rather than replaced with U+FFFD as encoding/json does, which
+// would let distinct inputs share a payload hash.
+func CanonicalJSON(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotCanonicalizable, err)
+	}
+	if err := checkUTF8Strings(reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	if err := checkJSONText(data); err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var out bytes.Buffer
+	if err := writeCanonicalValue(&out, decoder); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// canonicalMember is one object member with its canonical value already rendered
+type canonicalMember struct {
+	key   string
+	value []byte
+}
+
+// writeCanonicalValue reads the next JSON value from decoder and writes its canonical form
+func writeCanonicalValue(out *bytes.Buffer, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNotCanonicalizable, err)
+	}
+
+	switch token := token.(type) {
+	case nil:
+		out.WriteString("null")
+	case bool:
+		out.WriteString(strconv.FormatBool(token))
+	case string:
+		writeJSONString(out, token, false)
+	case json.Number:
+		number, err := canonicalNumber(token)
+		if err != nil {
+			return err
+		}
+		out.WriteString(number)
+	case json.Delim:
+		if token == '[' {
+			out.WriteByte('[')
+			for i := 0; decoder.More(); i++ {
+				if i > 0 {
+					out.WriteByte(',')
+				}
+				if err := writeCanonicalValue(out, decoder); err != nil {
+					return err
+				}
+			}
+			out.WriteByte(']')
+		} else {
+			var members []canonicalMember
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return fmt.Errorf("%w: %v", ErrNotCanonicalizable, err)
+				}
+				var value bytes.Buffer
+				if err := writeCanonicalValue(&value, decoder); err != nil {
+					return err
+				}
+				members = append(members, canonicalMember{key: key.(string), value: value.Bytes()})
+			}
+
+			sort.Slice(members, func(i, j int) bool {
+				return lessUTF16(members[i].key, members[j].key)
+			})
+			out.WriteByte('{')
+			for i, member := range members {
+				if i > 0 {
+					if members[i-1].key == member.key {
+						return fmt.Errorf("%w: duplicate key %q", ErrNotCanonicalizable, member.key)
+					}
+					out.WriteByte(',')
+				}
+				writeJSONString(out, member.key, false)
+				out.WriteByte(':')
+				out.Write(member.value)
+			}
+			out.WriteByte('}')
+		}
+		// Consume the closing delimiter
+		if _, err := decoder.Token(); err != nil {
+			return fmt.Errorf("%w: %v", ErrNotCanonicalizable, err)
+		}
+	}
+	return nil
+}
+
+// checkUTF8Strings rejects Go strings that json.Marshal would silently repair
+//
+// It walks the values json.Marshal encodes: exported struct fields, map keys
+// and elements, slices, arrays, pointers and interfaces. Types with their own
+// MarshalJSON or MarshalText are left to checkJSONText, since their output,
+// not their fields, is what gets encoded. json.Marshal has already succeeded,
+// so v has no cycles.
+func checkUTF8Strings(v reflect.Value) error {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if !utf8.ValidString(v.String()) {
+			return fmt.Errorf("%w: invalid UTF-8 in %q", ErrNotCanonicalizable, v.String())
+		}
+	case reflect.Pointer, reflect.Interface:
+		return checkUTF8Strings(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				if err := checkUTF8Strings(v.Field(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := checkUTF8Strings(iter.Key()); err != nil {
+				return err
+			}
+			if err := checkUTF8Strings(iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil // Encoded as base64
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := checkUTF8Strings(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Marshaler interfaces checked by checkUTF8Strings
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// checkJSONText rejects marshaled JSON whose strings are not valid Unicode
+//
+// Escapes only occur inside strings, so a linear scan for backslashes finds
+// every \u escape without tracking string boundaries.
+func checkJSONText(data []byte) error {
+	if !utf8.Valid(data) {
+		return fmt.Errorf("%w: invalid UTF-8", ErrNotCanonicalizable)
+	}
+
+	// escapeAt decodes the \uXXXX escape at data[i:], or returns -1
+	escapeAt := func(i int) rune {
+		if i+6 > len(data) || data[i] != '\\' || data[i+1] != 'u' {
+			return -1
+		}
+		value, err := strconv.ParseUint(string(data[i+2:i+6]), 16, 16)
+		if err != nil {
+			return -1
+		}
+		return rune(value)
+	}
+
+	for i := 0; i < len(data); i++ {
+		if data[i] != '\\' {
+			continue
+		}
+		r := escapeAt(i)
+		switch {
+		case utf16.IsSurrogate(r) && r < 0xdc00:
+			if low := escapeAt(i + 6); low < 0xdc00 || low > 0xdfff {
+				return fmt.Errorf("%w: lone surrogate \\u%04x", ErrNotCanonicalizable, r)
+			}
+			i += 6
+		case utf16.IsSurrogate(r):
+			return fmt.Errorf("%w: lone surrogate \\u%04x", ErrNotCanonicalizable, r)
+		}
+		// Skip the escaped character so an escaped backslash is not read as an escape
+		i++
+	}
+	return nil
+}
+
+// canonicalNumber formats a JSON number like ECMAScript's Number.prototype.toString
+//
+// The shortest round-tripping digits are laid out as a plain integer,
+// a decimal fraction, or exponent notation, using the ECMA-262 thresholds:
+// plain for exponents below 21 and fractions down to 1e-6.
+func canonicalNumber(number json.Number) (string, error) {
+	value, err := strconv.ParseFloat(string(number), 64)
+	if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+		return "", fmt.Errorf("%w: number %s is not a finite double", ErrNotCanonicalizable, number)
+	}
+	if value == 0 {
+		return "0", nil // Also covers -0
+	}
+
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	// "d.ddde±x" -> digits "dddd" and n such that value = 0.dddd × 10^n
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(value, 'e', -1, 64), "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	e, _ := strconv.Atoi(exponent)
+	n, k := e+1, len(digits)
+
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k), nil
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:], nil
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits, nil
+	}
+
+	suffix := "e+" + strconv.Itoa(n-1)
+	if n-1 < 0 {
+		suffix = "e-" + strconv.Itoa(1-n)
+	}
+	if k == 1 {
+		return sign + digits + suffix, nil
+	}
+	return sign + digits[:1] + "." + digits[1:] + suffix, nil
+}
+
+// JSONPayloadHash returns keccak256(CanonicalJSON(v))
+func JSONPayloadHash(v any) ([32]byte, error) {
+	canonical, err := CanonicalJSON(v)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(canonical)
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result, nil
+}
+
+// SignJSON signs JSONPayloadHash(v) with Sign and returns the 96-byte signature_info
+//
+// Any stack that implements RFC 8785 and keccak256 can recompute the payload
+// hash, so documents signed here verify elsewhere regardless of key order or
+// formatting.
+func SignJSON(privateKey ed25519.PrivateKey, v any) ([]byte, error) {
+	payloadHash, err := JSONPayloadHash(v)
+	if err != nil {
+		return nil, err
+	}
+	return Sign(privateKey, payloadHash)
+}
+
+// VerifyJSONPayload verifies a signature over JSONPayloadHash(v)
+//
+// It is the counterpart of SignJSON, named like VerifyStringPayload.
+func VerifyJSONPayload(signatureInfo []byte, v any) (ExecutionAddress, error) {
+	payloadHash, err := JSONPayloadHash(v)
+	if err != nil {
+		return ExecutionAddress{}, err
+	}
+	return Verify(signatureInfo, payloadHash)
+}
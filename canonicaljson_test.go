@@ -0,0 +1,129 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestCanonicalJSONNumbers tests number formatting against the RFC 8785 Appendix B vectors
+func TestCanonicalJSONNumbers(t *testing.T) {
+	for _, tc := range []struct {
+		bits uint64
+		want string
+	}{
+		{0x0000000000000000, "0"},
+		{0x8000000000000000, "0"},
+		{0x0000000000000001, "5e-324"},
+		{0x8000000000000001, "-5e-324"},
+		{0x7fefffffffffffff, "1.7976931348623157e+308"},
+		{0xffefffffffffffff, "-1.7976931348623157e+308"},
+		{0x4340000000000000, "9007199254740992"},
+		{0xc340000000000000, "-9007199254740992"},
+		{0x4430000000000000, "295147905179352830000"},
+		{0x44b52d02c7e14af5, "9.999999999999997e+22"},
+		{0x44b52d02c7e14af6, "1e+23"},
+		{0x44b52d02c7e14af7, "1.0000000000000001e+23"},
+		{0x444b1ae4d6e2ef4e, "999999999999999700000"},
+		{0x444b1ae4d6e2ef4f, "999999999999999900000"},
+		{0x444b1ae4d6e2ef50, "1e+21"},
+		{0x3eb0c6f7a0b5ed8c, "9.999999999999997e-7"},
+		{0x3eb0c6f7a0b5ed8d, "0.000001"},
+		{0x41b3de4355555553, "333333333.3333332"},
+		{0x41b3de4355555554, "333333333.33333325"},
+		{0x41b3de4355555555, "333333333.3333333"},
+		{0x41b3de4355555556, "333333333.3333334"},
+		{0x41b3de4355555557, "333333333.33333343"},
+		{0xbecbf647612f3696, "-0.0000033333333333333333"},
+		{0x43143ff3c1cb0959, "1424953923781206.2"},
+	} {
+		got, err := CanonicalJSON(math.Float64frombits(tc.bits))
+		if err != nil || string(got) != tc.want {
+			t.Errorf("%016x: expected %s, got %s, %v", tc.bits, tc.want, got, err)
+		}
+	}
+}
+
+// TestCanonicalJSONDocument tests sorting, escaping and whitespace against the RFC 8785 Section 3.2.2 example
+func TestCanonicalJSONDocument(t *testing.T) {
+	input := json.RawMessage(`{
+		"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+		"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+		"literals": [null, true, false]
+	}`)
+	want := `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`
+
+	got, err := CanonicalJSON(input)
+	if err != nil || string(got) != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+
+	// Keys sort by UTF-16 code units: U+FB33 sorts after the surrogate pair of U+1F600
+	got, err = CanonicalJSON(map[string]int{"דּ": 1, "\U0001f600": 2, "a": 3})
+	if want := "{\"a\":3,\"\U0001f600\":2,\"דּ\":1}"; err != nil || string(got) != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+}
+
+// TestCanonicalJSONRejects tests that values JCS cannot represent fail cleanly
+func TestCanonicalJSONRejects(t *testing.T) {
+	for name, v := range map[string]any{
+		"NaN":           math.NaN(),
+		"Inf":           math.Inf(-1),
+		"channel":       make(chan int),
+		"function":      func() {},
+		"duplicate key": json.RawMessage(`{"a":1,"a":2}`),
+		"overflow":      json.RawMessage(`1e400`),
+		"invalid UTF-8": "a\xffb",
+		"nested field":  []any{struct{ Name string }{"\xc3"}},
+		"map key":       map[string]int{"\xed\xa0\x80": 1},
+		"raw invalid":   json.RawMessage("\"a\xffb\""),
+		"lone high":     json.RawMessage(`"\ud800"`),
+		"lone low":      json.RawMessage(`"\udc00x"`),
+		"high then BMP": json.RawMessage(`"\ud800\u0041"`),
+		"key surrogate": json.RawMessage(`{"\udfff":1}`),
+	} {
+		if _, err := CanonicalJSON(v); !errors.Is(err, ErrNotCanonicalizable) {
+			t.Errorf("%s: expected ErrNotCanonicalizable, got %v", name, err)
+		}
+	}
+
+	// Valid pairs, literal U+FFFD and escaped backslashes still canonicalize
+	for input, want := range map[string]string{
+		`"\ud83d\ude00"`: "\"\U0001F600\"",
+		"\"\uFFFD\"":     "\"\uFFFD\"",
+		`"\\ud800"`:      `"\\ud800"`,
+	} {
+		got, err := CanonicalJSON(json.RawMessage(input))
+		if err != nil || string(got) != want {
+			t.Errorf("%s: expected %s, got %s, %v", input, want, got, err)
+		}
+	}
+}
+
+// TestSignJSONRoundTrip tests that equivalent documents verify and different ones do not
+func TestSignJSONRoundTrip(t *testing.T) {
+	privateKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	document := map[string]any{"amount": 4.5, "to": "0x00", "memo": "€"}
+
+	signatureInfo, err := SignJSON(privateKey, document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same document with other key order and number spelling
+	reordered := json.RawMessage(`{"memo":"€", "to":"0x00", "amount":4.50}`)
+	if address, err := VerifyJSONPayload(signatureInfo, reordered); err != nil || address != KnownAnswerTests[0].Address {
+		t.Errorf("Expected %s, got %s, %v", KnownAnswerTests[0].Address, address, err)
+	}
+
+	document["amount"] = 4.6
+	if _, err := VerifyJSONPayload(signatureInfo, document); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if _, err := SignJSON(privateKey, math.NaN()); !errors.Is(err, ErrNotCanonicalizable) {
+		t.Errorf("Expected ErrNotCanonicalizable, got %v", err)
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+)
+
+// runCommand dispatches a CLI subcommand
+func runCommand(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New("missing command")
+	}
+
+	switch args[0] {
+	case "spec":
+		return runSpec(args[1:], stdout)
+	case "bench":
+		return runBench(args[1:], stdout)
+	case "keys":
+		return runKeys(args[1:], stdout)
+	case "genesis-keys":
+		return runGenesisKeys(args[1:], stdout)
+	case "calibrate":
+		return runCalibrate(args[1:], stdout)
+	case "verify":
+		return runVerify(args[1:], stdout)
+	case "diff":
+		return runDiff(args[1:], stdout)
+	case "fixtures":
+		return runFixtures(args[1:], stdout)
+	case "inspect":
+		return runInspect(args[1:], stdout)
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// runSpec prints the implementation descriptor
+func runSpec(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("spec", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "print the descriptor as JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	spec := eip7980.SpecDescriptor()
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(spec)
+	}
+
+	fmt.Fprintf(stdout, "EIP: %d\n", spec.EIP)
+	fmt.Fprintf(stdout, "Algorithm Type: 0x%02x\n", spec.AlgType)
+	fmt.Fprintf(stdout, "Gas Penalty: %d\n", spec.GasPenalty)
+	fmt.Fprintf(stdout, "Max Size: %d bytes\n", spec.MaxSize)
+	fmt.Fprintf(stdout, "Verification Modes: %v\n", spec.VerificationModes)
+	fmt.Fprintf(stdout, "Version: %s\n", spec.Version)
+	fmt.Fprintf(stdout, "Commitment Hash: %s\n", spec.CommitmentHash)
+	return nil
+}
+
+// runBench runs a capacity-planning benchmark and prints the report
+func runBench(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	duration := flags.Duration("duration", 10*time.Second, "measurement duration")
+	workers := flags.Int("workers", 0, "concurrent workers, also used as GOMAXPROCS (default: number of CPUs)")
+	mode := flags.String("mode", eip7980.BenchModeSingle, "verification API to benchmark: single, verifier or batch")
+	asJSON := flags.Bool("json", false, "print the report as JSON")
+	compare := flags.Bool("compare-backends", false, "benchmark every registered backend and print benchstat lines")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *compare {
+		return runCompareBackends(eip7980.BenchConfig{Duration: *duration, Workers: *workers}, *asJSON, stdout)
+	}
+
+	report, err := eip7980.RunBench(eip7980.BenchConfig{
+		Duration: *duration,
+		Workers:  *workers,
+		Mode:     *mode,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return json.NewEncoder(stdout).Encode(report)
+	}
+
+	fmt.Fprintf(stdout, "Mode: %s\n", report.Mode)
+	fmt.Fprintf(stdout, "Workers: %d (GOMAXPROCS %d, %d CPUs)\n", report.Workers, report.GOMAXPROCS, report.NumCPU)
+	fmt.Fprintf(stdout, "Operations: %d in %v\n", report.Ops, report.Duration)
+	fmt.Fprintf(stdout, "Throughput: %.0f ops/sec\n", report.OpsPerSec)
+	fmt.Fprintf(stdout, "Latency: p50 %v, p99 %v\n", report.P50, report.P99)
+	fmt.Fprintf(stdout, "Allocations: %.2f allocs/op\n", report.AllocsPerOp)
+	return nil
+}
+
+// runCompareBackends benchmarks every registered backend and fails if any diverges from the stdlib
+func runCompareBackends(cfg eip7980.BenchConfig, asJSON bool, stdout io.Writer) error {
+	reports, err := eip7980.CompareBackends(cfg)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		err = json.NewEncoder(stdout).Encode(reports)
+	} else {
+		err = eip7980.WriteBenchstat(stdout, reports)
+	}
+	if err != nil {
+		return err
+	}
+
+	for name, report := range reports {
+		if report.Diverged > 0 {
+			return fmt.Errorf("backend %s disagrees with %s on %d inputs", name, eip7980.DEFAULT_BACKEND_NAME, report.Diverged)
+		}
+	}
+	return nil
+}
+
+// runCalibrate measures verification timings for gas calibration research
+func runCalibrate(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("calibrate", flag.ContinueOnError)
+	n := flags.Int("n", 10000, "number of timed verifications")
+	asCSV := flags.Bool("csv", false, "print the histogram as CSV")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	histogram, err := eip7980.CalibrationRun(eip7980.CalibrationConfig{N: *n})
+	if err != nil {
+		return err
+	}
+
+	if *asCSV {
+		return histogram.WriteCSV(stdout)
+	}
+
+	fmt.Fprintf(stdout, "Samples: %d\n", histogram.Total)
+	fmt.Fprintf(stdout, "Min: %v, Max: %v\n", histogram.Min, histogram.Max)
+	fmt.Fprintf(stdout, "p50: %v, p90: %v, p99: %v\n", histogram.P50, histogram.P90, histogram.P99)
+	for i, count := range histogram.Counts {
+		if i < len(histogram.Bounds) {
+			fmt.Fprintf(stdout, "<= %v: %d\n", histogram.Bounds[i], count)
+		} else {
+			fmt.Fprintf(stdout, "> %v: %d\n", histogram.Bounds[len(histogram.Bounds)-1], count)
+		}
+	}
+	return nil
+}
+
+// runFixtures writes the known-answer tests and deterministic vectors as Solidity or JSON fixtures
+func runFixtures(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("fixtures", flag.ContinueOnError)
+	langName := flags.String("lang", "json", "output language: json or solidity")
+	count := flags.Int("count", 8, "number of generated vectors after the known-answer tests")
+	out := flags.String("out", "", "output file (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *count < 0 {
+		return errors.New("--count must not be negative")
+	}
+
+	lang, err := eip7980.ParseLang(*langName)
+	if err != nil {
+		return fmt.Errorf("--lang: %w", err)
+	}
+
+	var buf bytes.Buffer
+	vectors := append(slices.Clone(eip7980.KnownAnswerTests), eip7980.DeterministicVectors(*count)...)
+	if err := eip7980.ExportFixtures(&buf, lang, vectors); err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err := stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(*out, buf.Bytes(), 0o644)
+}
+
+// runInspect prints an address, given as hex or ICAP, in every display form
+//
+// With --addressbook it also prints the address's label from the book.
+func runInspect(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	bookPath := flags.String("addressbook", "", "address book file (.csv, otherwise JSON) to label the address from")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("inspect requires one address (0x hex or ICAP)")
+	}
+	input := flags.Arg(0)
+
+	var book *eip7980.AddressBook
+	if *bookPath != "" {
+		var err error
+		if book, err = loadAddressBook(*bookPath); err != nil {
+			return fmt.Errorf("--addressbook: %w", err)
+		}
+	}
+
+	var address eip7980.ExecutionAddress
+	var err error
+	if strings.HasPrefix(strings.ToUpper(input), "XE") {
+		address, err = eip7980.ParseICAP(input)
+	} else {
+		address, err = eip7980.ParseAddress(input, false)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Raw:    %s\n", address)
+	fmt.Fprintf(stdout, "EIP-55: %s\n", address.ChecksumString())
+	fmt.Fprintf(stdout, "ICAP:   %s\n", address.ICAP())
+	if book != nil {
+		label, ok := book.Label(address)
+		if !ok {
+			label = "(none)"
+		}
+		fmt.Fprintf(stdout, "Label:  %s\n", label)
+	}
+	return nil
+}
+
+// loadAddressBook reads an address book written by AddressBook.WriteCSV or WriteJSON
+func loadAddressBook(path string) (*eip7980.AddressBook, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	book := eip7980.NewAddressBook()
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		err = book.ReadCSV(file, false)
+	} else {
+		err = book.ReadJSON(file, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+// runVerify verifies a hex signature_info against a payload hash and prints the sender
+func runVerify(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	sig := flags.String("sig", "", "signature_info as 192 hex characters (signature || public key)")
+	hash := flags.String("hash", "", "payload hash as 64 hex characters, 0x prefix optional")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *sig == "" || *hash == "" {
+		return errors.New("verify requires --sig and --hash")
+	}
+
+	payloadHash, err := eip7980.ParseHash32(*hash)
+	if err != nil {
+		return fmt.Errorf("--hash: %w", err)
+	}
+	signatureInfo, err := hex.DecodeString(strings.TrimPrefix(*sig, "0x"))
+	if err != nil {
+		return fmt.Errorf("--sig: %w", err)
+	}
+
+	address, err := eip7980.Verify(signatureInfo, payloadHash)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, address)
+	return nil
+}
+
+// runDiff prints an annotated comparison of two hex signature_info blobs
+func runDiff(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	hash := flags.String("hash", "", "payload hash to verify both blobs against")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return errors.New("usage: diff [--hash <hash>] <hexA> <hexB>")
+	}
+
+	blobs := make([][]byte, 2)
+	for i, arg := range flags.Args() {
+		blob, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+		if err != nil {
+			return fmt.Errorf("argument %d: %w", i+1, err)
+		}
+		blobs[i] = blob
+	}
+
+	fmt.Fprint(stdout, eip7980.DiffSignatureInfo(blobs[0], blobs[1]))
+
+	if *hash == "" {
+		return nil
+	}
+	payloadHash, err := eip7980.ParseHash32(*hash)
+	if err != nil {
+		return fmt.Errorf("--hash: %w", err)
+	}
+	for i, name := range []string{"a", "b"} {
+		if address, err := eip7980.Verify(blobs[i], payloadHash); err != nil {
+			fmt.Fprintf(stdout, "verify %s: %v\n", name, err)
+		} else {
+			fmt.Fprintf(stdout, "verify %s: ok, %s\n", name, address)
+		}
+	}
+	return nil
+}
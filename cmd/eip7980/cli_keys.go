@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+	"golang.org/x/crypto/sha3"
+)
+
+// defaultPasswordEnv names the environment variable holding the bundle password
+const defaultPasswordEnv = "EIP7980_BUNDLE_PASSWORD"
+
+// keyFileEntry is the JSON form of a labeled key read by "keys export" and written by "keys import"
+type keyFileEntry struct {
+	Label   string `json:"label"`
+	Seed    string `json:"seed"`
+	Address string `json:"address,omitempty"`
+}
+
+// runKeys dispatches the keys subcommands
+func runKeys(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New("missing keys command: export or import")
+	}
+
+	switch args[0] {
+	case "export":
+		return runKeysExport(args[1:], stdout)
+	case "import":
+		return runKeysImport(args[1:], stdout)
+	default:
+		return fmt.Errorf("unknown keys command %q", args[0])
+	}
+}
+
+// bundlePassword reads the bundle password from the named environment variable
+func bundlePassword(env string) (string, error) {
+	password, ok := os.LookupEnv(env)
+	if !ok || password == "" {
+		return "", fmt.Errorf("bundle password not set in $%s", env)
+	}
+	return password, nil
+}
+
+// runKeysExport encrypts a JSON key file into a bundle
+func runKeysExport(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("keys export", flag.ContinueOnError)
+	in := flags.String("in", "", "JSON key file: [{\"label\": ..., \"seed\": \"<hex>\", \"address\": optional check}]")
+	out := flags.String("out", "", "bundle output path")
+	passwordEnv := flags.String("password-env", defaultPasswordEnv, "environment variable holding the bundle password")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" || *out == "" {
+		return errors.New("keys export requires --in and --out")
+	}
+
+	password, err := bundlePassword(*passwordEnv)
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+	var entries []keyFileEntry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return fmt.Errorf("parse key file: %w", err)
+	}
+
+	keys := make([]eip7980.LabeledKey, len(entries))
+	for i, entry := range entries {
+		seed, err := hex.DecodeString(strings.TrimPrefix(entry.Seed, "0x"))
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return fmt.Errorf("key %d (%s): seed must be %d hex-encoded bytes", i, entry.Label, ed25519.SeedSize)
+		}
+		keys[i], _ = eip7980.NewLabeledKey(entry.Label, ed25519.NewKeyFromSeed(seed))
+
+		if entry.Address != "" {
+			address, err := eip7980.ParseAddress(entry.Address, false)
+			if err != nil {
+				return fmt.Errorf("key %d (%s): %w", i, entry.Label, err)
+			}
+			if address != keys[i].Address {
+				return fmt.Errorf("key %d (%s): address %s does not match seed, expected %s", i, entry.Label, entry.Address, keys[i].Address)
+			}
+		}
+	}
+
+	bundle, err := eip7980.ExportBundle(keys, password)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, bundle, 0o600); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Exported %d keys to %s\n", len(keys), *out)
+	return nil
+}
+
+// runKeysImport decrypts a bundle and prints its keys as a JSON key file
+func runKeysImport(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("keys import", flag.ContinueOnError)
+	in := flags.String("in", "", "bundle path")
+	passwordEnv := flags.String("password-env", defaultPasswordEnv, "environment variable holding the bundle password")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" {
+		return errors.New("keys import requires --in")
+	}
+
+	password, err := bundlePassword(*passwordEnv)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := os.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+	keys, err := eip7980.ImportBundle(bundle, password)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]keyFileEntry, len(keys))
+	for i, key := range keys {
+		entries[i] = keyFileEntry{
+			Label:   key.Label,
+			Seed:    hex.EncodeToString(key.PrivateKey.Seed()),
+			Address: key.Address.String(),
+		}
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// weiUnits maps the balance suffixes accepted by parseWei to their value in wei
+var weiUnits = []struct {
+	suffix string
+	wei    *big.Int
+}{
+	{"ether", new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)},
+	{"gwei", big.NewInt(1_000_000_000)},
+	{"wei", big.NewInt(1)},
+}
+
+// parseWei parses a whole-number balance such as "100ether", "5gwei" or "42" (wei)
+func parseWei(s string) (*big.Int, error) {
+	amount, unit := s, big.NewInt(1)
+	for _, u := range weiUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			amount, unit = strings.TrimSuffix(s, u.suffix), u.wei
+			break
+		}
+	}
+
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid balance %q: want a whole number with optional ether, gwei or wei suffix", s)
+	}
+	return value.Mul(value, unit), nil
+}
+
+// runGenesisKeys generates funded devnet accounts as a genesis alloc fragment plus an encrypted key bundle
+func runGenesisKeys(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("genesis-keys", flag.ContinueOnError)
+	count := flags.Int("count", 100, "number of accounts")
+	balance := flags.String("balance", "100ether", "balance per account, e.g. 100ether, 5gwei or 42 (wei)")
+	seed := flags.String("seed", "", "seed string for reproducible keys (default random)")
+	alloc := flags.String("alloc", "", "genesis alloc JSON output path (default stdout)")
+	bundle := flags.String("bundle", "", "encrypted key bundle output path")
+	passwordEnv := flags.String("password-env", defaultPasswordEnv, "environment variable holding the bundle password")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *bundle == "" {
+		return errors.New("genesis-keys requires --bundle")
+	}
+	if *count < 1 {
+		return errors.New("--count must be at least 1")
+	}
+
+	fundWei, err := parseWei(*balance)
+	if err != nil {
+		return fmt.Errorf("--balance: %w", err)
+	}
+	password, err := bundlePassword(*passwordEnv)
+	if err != nil {
+		return err
+	}
+
+	var random io.Reader
+	if *seed != "" {
+		shake := sha3.NewShake256()
+		shake.Write([]byte(*seed))
+		random = shake
+	}
+	keys, err := eip7980.GenerateKeys(*count, random)
+	if err != nil {
+		return err
+	}
+
+	var manifest bytes.Buffer
+	if err := eip7980.WriteManifest(&manifest, keys, fundWei); err != nil {
+		return err
+	}
+	encrypted, err := eip7980.ExportBundle(keys, password)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*bundle, encrypted, 0o600); err != nil {
+		return err
+	}
+
+	if *alloc == "" {
+		_, err := stdout.Write(manifest.Bytes())
+		return err
+	}
+	if err := os.WriteFile(*alloc, manifest.Bytes(), 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "Generated %d accounts: alloc %s, bundle %s\n", len(keys), *alloc, *bundle)
+	return nil
+}
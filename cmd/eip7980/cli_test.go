@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+)
+
+// TestSpecCommandJSON tests that "spec --json" prints the locked descriptor
+func TestSpecCommandJSON(t *testing.T) {
+	var out bytes.Buffer
+	if err := runCommand([]string{"spec", "--json"}, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile(filepath.Join("..", "..", "testdata", "spec.golden.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("spec --json output differs from golden file:\ngot:\n%s\nwant:\n%s", out.Bytes(), want)
+	}
+}
+
+// TestUnknownCommand tests that unknown subcommands are rejected
+func TestUnknownCommand(t *testing.T) {
+	if err := runCommand([]string{"nope"}, &bytes.Buffer{}); err == nil {
+		t.Errorf("Expected error for unknown command")
+	}
+	if err := runCommand(nil, &bytes.Buffer{}); err == nil {
+		t.Errorf("Expected error for missing command")
+	}
+}
+
+// TestBenchCompareBackends tests that --compare-backends prints benchstat lines
+//
+// The 20ms duration keeps it fast enough for go test -short.
+func TestBenchCompareBackends(t *testing.T) {
+	var out bytes.Buffer
+	if err := runCommand([]string{"bench", "--compare-backends", "--duration", "20ms", "--workers", "1"}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "BenchmarkVerify/backend=stdlib-1\t") {
+		t.Errorf("Expected a stdlib benchmark line, got:\n%s", out.String())
+	}
+}
+
+// TestKeysExportAddressCheck tests that key file addresses go through eip7980.ParseAddress
+func TestKeysExportAddressCheck(t *testing.T) {
+	t.Setenv(defaultPasswordEnv, "password")
+	dir := t.TempDir()
+	seed := strings.Repeat("00", 32)
+
+	for _, tc := range []struct {
+		address string
+		want    string
+	}{
+		{"0x97b1c813eae702332ba3eaa1625f942c5472626d", "does not match seed"},
+		{"431540d1f4faecdc7f7259797f629530995e7c6c", "missing 0x prefix"},
+	} {
+		in := filepath.Join(dir, "keys.json")
+		keyFile := `[{"label": "a", "seed": "` + seed + `", "address": "` + tc.address + `"}]`
+		if err := os.WriteFile(in, []byte(keyFile), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		err := runCommand([]string{"keys", "export", "--in", in, "--out", filepath.Join(dir, "out")}, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("Address %s: expected error containing %q, got %v", tc.address, tc.want, err)
+		}
+	}
+
+	_, err := eip7980.ParseAddress("431540d1f4faecdc7f7259797f629530995e7c6c", false)
+	if !errors.Is(err, eip7980.ErrInvalidAddress) {
+		t.Errorf("Expected ErrInvalidAddress, got %v", err)
+	}
+}
+
+// TestVerifyCommandHash tests that --hash goes through eip7980.ParseHash32
+func TestVerifyCommandHash(t *testing.T) {
+	kat := eip7980.KnownAnswerTests[0]
+	sig := hex.EncodeToString(kat.SignatureInfo())
+
+	for _, tc := range []struct {
+		hash string
+		err  error
+	}{
+		{"0x" + hex.EncodeToString(kat.PayloadHash[:]), nil},
+		{hex.EncodeToString(kat.PayloadHash[:]), nil},
+		{strings.Repeat("0", 63), eip7980.ErrHashOddLength},
+		{strings.Repeat("0", 62), eip7980.ErrHashLength},
+		{strings.Repeat("x", 64), eip7980.ErrHashNotHex},
+	} {
+		var out bytes.Buffer
+		err := runCommand([]string{"verify", "--sig", sig, "--hash", tc.hash}, &out)
+		if tc.err == nil {
+			if err != nil || strings.TrimSpace(out.String()) != kat.Address.String() {
+				t.Errorf("Hash %s: expected %s, got %q, %v", tc.hash, kat.Address, out.String(), err)
+			}
+			continue
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Hash %s: expected %v, got %v", tc.hash, tc.err, err)
+		}
+	}
+}
+
+// TestDiffCommand tests the annotated dump and per-blob verification
+func TestDiffCommand(t *testing.T) {
+	kat := eip7980.KnownAnswerTests[0]
+	a := kat.SignatureInfo()
+	b := append([]byte(nil), a...)
+	b[40] ^= 0x01
+
+	var out bytes.Buffer
+	args := []string{"diff", "--hash", hex.EncodeToString(kat.PayloadHash[:]), hex.EncodeToString(a), hex.EncodeToString(b)}
+	if err := runCommand(args, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"differs: S bytes 40..40", "verify a: ok, " + kat.Address.String(), "verify b: ed25519 signature verification failed"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+
+	if err := runCommand([]string{"diff", "00"}, &bytes.Buffer{}); err == nil {
+		t.Errorf("Expected error for a single argument")
+	}
+}
+
+// TestFixturesCommand tests that the CLI emits the library's golden fixtures
+func TestFixturesCommand(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		lang   string
+		golden string
+	}{
+		{"json", "fixtures.golden.json"},
+		{"solidity", "fixtures.golden.sol"},
+	} {
+		out := filepath.Join(dir, tc.golden)
+		if err := runCommand([]string{"fixtures", "--lang", tc.lang, "--count", "3", "--out", out}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile(filepath.Join("..", "..", "testdata", tc.golden))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("fixtures --lang %s output differs from golden file", tc.lang)
+		}
+	}
+
+	if err := runCommand([]string{"fixtures", "--lang", "vyper"}, &bytes.Buffer{}); err == nil {
+		t.Errorf("Expected error for unsupported language")
+	}
+}
+
+// TestGenesisKeysCommand tests reproducible allocs and that the bundle holds the funded keys
+func TestGenesisKeysCommand(t *testing.T) {
+	t.Setenv(defaultPasswordEnv, "password")
+	dir := t.TempDir()
+
+	allocs := make([]map[string]map[string]string, 2)
+	for i := range allocs {
+		bundle := filepath.Join(dir, "bundle")
+		var out bytes.Buffer
+		args := []string{"genesis-keys", "--count", "3", "--balance", "2gwei", "--seed", "devnet", "--bundle", bundle}
+		if err := runCommand(args, &out); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(out.Bytes(), &allocs[i]); err != nil {
+			t.Fatalf("Alloc is not valid JSON: %v", err)
+		}
+
+		data, err := os.ReadFile(bundle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys, err := eip7980.ImportBundle(data, "password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range keys {
+			if allocs[i][key.Address.String()]["balance"] != "0x77359400" {
+				t.Errorf("Bundle key %s is not funded with 2 gwei in %v", key.Address, allocs[i])
+			}
+		}
+	}
+	if len(allocs[0]) != 3 || !reflect.DeepEqual(allocs[0], allocs[1]) {
+		t.Errorf("Expected identical 3-account allocs from the same seed, got %v and %v", allocs[0], allocs[1])
+	}
+
+	for _, balance := range []string{"1.5ether", "-1", "tenwei"} {
+		err := runCommand([]string{"genesis-keys", "--balance", balance, "--bundle", filepath.Join(dir, "b")}, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), "--balance") {
+			t.Errorf("Balance %q: expected --balance error, got %v", balance, err)
+		}
+	}
+}
+
+// TestInspectCommand tests that hex and ICAP inputs print the same forms
+func TestInspectCommand(t *testing.T) {
+	const hexAddress = "0x00c5496aee77c1ba1f0854206a26dda82a81d6d8"
+	const icap = "XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZS"
+
+	var fromHex, fromICAP bytes.Buffer
+	if err := runCommand([]string{"inspect", hexAddress}, &fromHex); err != nil {
+		t.Fatal(err)
+	}
+	if err := runCommand([]string{"inspect", icap}, &fromICAP); err != nil {
+		t.Fatal(err)
+	}
+	if fromHex.String() != fromICAP.String() {
+		t.Errorf("Hex and ICAP inputs differ:\n%s\n%s", fromHex.String(), fromICAP.String())
+	}
+	for _, want := range []string{"Raw:    " + hexAddress, "ICAP:   " + icap, "EIP-55: 0x00c5496aEe77C1bA1f0854206A26DdA82a81D6D8"} {
+		if !strings.Contains(fromHex.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, fromHex.String())
+		}
+	}
+
+	if err := runCommand([]string{"inspect", "XE81ETHXREGGAVOFYORK"}, &bytes.Buffer{}); !errors.Is(err, eip7980.ErrICAPIndirect) {
+		t.Errorf("Expected ErrICAPIndirect, got %v", err)
+	}
+	if strings.Contains(fromHex.String(), "Label:") {
+		t.Errorf("Expected no label line without --addressbook, got:\n%s", fromHex.String())
+	}
+}
+
+// TestInspectAddressBook tests that --addressbook labels known addresses in JSON and CSV books
+func TestInspectAddressBook(t *testing.T) {
+	const labeled = "0x00c5496aee77c1ba1f0854206a26dda82a81d6d8"
+	const unlabeled = "0x431540d1f4faecdc7f7259797f629530995e7c6c"
+
+	address, err := eip7980.ParseAddress(labeled, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := eip7980.NewAddressBook()
+	if err := book.Set("devnet faucet", address); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	var asJSON, asCSV bytes.Buffer
+	if err := book.WriteJSON(&asJSON); err != nil {
+		t.Fatal(err)
+	}
+	if err := book.WriteCSV(&asCSV); err != nil {
+		t.Fatal(err)
+	}
+	paths := map[string][]byte{filepath.Join(dir, "book.json"): asJSON.Bytes(), filepath.Join(dir, "book.csv"): asCSV.Bytes()}
+
+	for path, data := range paths {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		for input, want := range map[string]string{labeled: "Label:  devnet faucet\n", unlabeled: "Label:  (none)\n"} {
+			var out bytes.Buffer
+			if err := runCommand([]string{"inspect", "--addressbook", path, input}, &out); err != nil {
+				t.Fatalf("%s: %v", filepath.Base(path), err)
+			}
+			if !strings.Contains(out.String(), want) || !strings.Contains(out.String(), "EIP-55: ") {
+				t.Errorf("%s, %s: expected %q, got:\n%s", filepath.Base(path), input, want, out.String())
+			}
+		}
+	}
+
+	if err := runCommand([]string{"inspect", "--addressbook", filepath.Join(dir, "missing.json"), labeled}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "--addressbook") {
+		t.Errorf("Expected --addressbook error for a missing file, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+// Command eip7980 demonstrates EIP-7980 verification and provides tooling
+// subcommands (spec, bench, keys) on top of the eip7980 package
+package main
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"os"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+)
+
+// Example usage
+//
+// With arguments, main runs the named subcommand instead (e.g. "spec --json").
+func main() {
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1:], os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Println("EIP-7980: Ed25519 Transaction Signature Verification")
+	fmt.Printf("Algorithm Type: 0x%02x\n", eip7980.ALG_TYPE)
+	fmt.Printf("Gas Penalty: %d\n", eip7980.GAS_PENALTY)
+	fmt.Printf("Max Size: %d bytes\n", eip7980.MAX_SIZE)
+
+	// Example: Create a test signature (in production, this comes from a transaction)
+	// Generate Ed25519 keypair
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		fmt.Printf("Error generating key: %v\n", err)
+		return
+	}
+
+	// Create a mock payload hash (32 bytes)
+	payloadHash := [32]byte{}
+	copy(payloadHash[:], []byte("example transaction payload hash"))
+
+	// Sign the payload
+	signature := ed25519.Sign(privateKey, payloadHash[:])
+
+	// Construct signature_info (96 bytes)
+	signatureInfo := make([]byte, eip7980.MAX_SIZE)
+	copy(signatureInfo[:64], signature)
+	copy(signatureInfo[64:], publicKey)
+
+	// Verify the signature and derive address
+	address, err := eip7980.Verify(signatureInfo, payloadHash)
+	if err != nil {
+		fmt.Printf("Verification failed: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n Signature verified successfully!\n")
+	fmt.Printf("Derived Ethereum Address: %s\n", address.String())
+}
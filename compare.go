@@ -0,0 +1,247 @@
+package eip7980
+
+import (
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"sync"
+
+	"filippo.io/edwards25519"
+)
+
+// DEFAULT_BACKEND_NAME is the registered name of StdlibBackend, the reference for CompareBackends
+const DEFAULT_BACKEND_NAME = "stdlib"
+
+// ErrBackendRegistered is returned when registering a backend name twice
+var ErrBackendRegistered = errors.New("backend already registered")
+
+// backendRegistry holds the named backends compared by CompareBackends
+var backendRegistry = struct {
+	sync.Mutex
+	backends map[string]Backend
+}{backends: map[string]Backend{DEFAULT_BACKEND_NAME: StdlibBackend{}}}
+
+// RegisterBackend makes backend available to CompareBackends under name
+//
+// Registration does not change which backend Verify uses; see SetBackend.
+func RegisterBackend(name string, backend Backend) error {
+	if name == "" || backend == nil {
+		return errors.New("backend name and implementation are required")
+	}
+
+	backendRegistry.Lock()
+	defer backendRegistry.Unlock()
+	if _, ok := backendRegistry.backends[name]; ok {
+		return fmt.Errorf("%w: %q", ErrBackendRegistered, name)
+	}
+	backendRegistry.backends[name] = backend
+	return nil
+}
+
+// registeredBackends returns a snapshot of the registry
+func registeredBackends() map[string]Backend {
+	backendRegistry.Lock()
+	defer backendRegistry.Unlock()
+
+	backends := make(map[string]Backend, len(backendRegistry.backends))
+	for name, backend := range backendRegistry.backends {
+		backends[name] = backend
+	}
+	return backends
+}
+
+// CompareBackends benchmarks every registered backend on the same inputs in one process
+//
+// Each backend runs RunBench in BenchModeVerifier over DeterministicVectors,
+// one after another with the same cfg, so results are comparable across
+// backends. Before measuring, every backend's accept/reject decision on the
+// vectors, tampered copies of them and known Ed25519 edge cases (small-order
+// and non-canonical points, S >= L, mixed-order R) is checked against
+// StdlibBackend; the number of disagreements is reported in
+// BenchReport.Diverged. cfg.Mode
+// is ignored. Use WriteBenchstat to print the reports for benchstat.
+func CompareBackends(cfg BenchConfig) (map[string]BenchReport, error) {
+	vectors := DeterministicVectors(benchWorkloadSize)
+	workload := &benchWorkload{
+		signatureInfos: make([][]byte, len(vectors)),
+		payloadHashes:  make([][32]byte, len(vectors)),
+	}
+	for i, vector := range vectors {
+		workload.signatureInfos[i] = vector.SignatureInfo()
+		workload.payloadHashes[i] = vector.PayloadHash
+	}
+
+	cfg.Mode = BenchModeVerifier
+	reports := make(map[string]BenchReport)
+	for name, backend := range registeredBackends() {
+		report, err := runBench(cfg, func() (benchStep, error) {
+			verifier := NewVerifier()
+			verifier.Backend = backend
+			return verifyStep(workload, verifier.Verify), nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("backend %s: %w", name, err)
+		}
+
+		report.Backend = name
+		report.Diverged = backendDivergence(backend, vectors)
+		reports[name] = report
+	}
+	return reports, nil
+}
+
+// backendDivergence counts inputs where backend and StdlibBackend disagree
+//
+// The inputs are each vector plus copies with R, S, the public key or the
+// payload hash corrupted, so both accepting and rejecting paths are exercised,
+// and then the backendEdgeCases, which go to the backends directly: Verify
+// rejects some of them before the Backend is called, which would hide how
+// the backend itself treats them.
+func backendDivergence(backend Backend, vectors []KAT) int {
+	reference := &Verifier{Backend: StdlibBackend{}}
+	candidate := &Verifier{Backend: backend}
+
+	diverged := 0
+	for _, vector := range vectors {
+		for _, corrupt := range []int{-1, 0, 32, 64} {
+			signatureInfo := vector.SignatureInfo()
+			payloadHash := vector.PayloadHash
+			if corrupt >= 0 {
+				signatureInfo[corrupt] ^= 0x01
+			}
+
+			_, want := reference.Verify(signatureInfo, payloadHash)
+			_, got := candidate.Verify(signatureInfo, payloadHash)
+			if (want == nil) != (got == nil) {
+				diverged++
+			}
+		}
+
+		payloadHash := vector.PayloadHash
+		payloadHash[0] ^= 0x01
+		_, want := reference.Verify(vector.SignatureInfo(), payloadHash)
+		_, got := candidate.Verify(vector.SignatureInfo(), payloadHash)
+		if (want == nil) != (got == nil) {
+			diverged++
+		}
+	}
+
+	for _, input := range backendEdgeCases() {
+		want := StdlibBackend{}.Verify(input.publicKey, input.message, input.signature)
+		got := backend.Verify(input.publicKey, append([]byte(nil), input.message...), input.signature)
+		if want != got {
+			diverged++
+		}
+	}
+	return diverged
+}
+
+// backendInput is the arguments of one Backend.Verify call
+type backendInput struct {
+	publicKey, message, signature []byte
+}
+
+// backendEdgeCases returns inputs on which Ed25519 implementations are known to disagree
+//
+// They follow the cases catalogued in "Taming the many EdDSAs" (Chalkias,
+// Garillot and Nikolaenko, 2020): small-order public keys, non-canonical
+// encodings of R and of the public key, S >= L, and a mixed-order R that
+// cofactored verification accepts and cofactorless verification rejects.
+// StdlibBackend's decision on each is the reference, whatever it is.
+func backendEdgeCases() []backendInput {
+	// The identity (y = 1) canonically and as y = p + 1, a point of order 8,
+	// and L = 2^252 + 27742317777372353535851937790883648493, all little-endian
+	var identity, identityNonCanonical, torsion, groupOrder [32]byte
+	mustDecodeInto(identity[:], "0100000000000000000000000000000000000000000000000000000000000000")
+	mustDecodeInto(identityNonCanonical[:], "eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	mustDecodeInto(torsion[:], "c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	mustDecodeInto(groupOrder[:], "edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010")
+
+	// Only the configuration could fail, and it is fixed
+	generator, _ := NewGenerator(GeneratorConfig{Keys: 1})
+	item := generator.Next()
+	seed := generator.keys[0].Seed()
+	message := item.PayloadHash
+	signature, publicKey := item.SignatureInfo[:64], item.SignatureInfo[64:]
+
+	// Identity R with S = 0 satisfies [S]B = R + [k]A whenever A is the identity
+	identitySignature := append(identity[:], make([]byte, 32)...)
+	nonCanonicalR := append(identityNonCanonical[:], make([]byte, 32)...)
+
+	// S + L is the same scalar, encoded non-canonically
+	highS := append([]byte(nil), signature...)
+	carry := 0
+	for i := 0; i < 32; i++ {
+		sum := int(highS[32+i]) + int(groupOrder[i]) + carry
+		highS[32+i], carry = byte(sum), sum>>8
+	}
+
+	return []backendInput{
+		{publicKey, message[:], signature},
+		{identity[:], message[:], identitySignature},
+		{torsion[:], message[:], identitySignature},
+		{identity[:], message[:], nonCanonicalR},
+		{identityNonCanonical[:], message[:], identitySignature},
+		{publicKey, message[:], highS},
+		{publicKey, message[:], mixedOrderSignature(seed, publicKey, message[:], torsion[:])},
+	}
+}
+
+// mixedOrderSignature signs message with R = rB + T for the order-8 point T
+//
+// [S]B - [k]A equals R - T, so the signature verifies only when the equation
+// is multiplied by the cofactor.
+func mixedOrderSignature(seed, publicKey, message, torsionPoint []byte) []byte {
+	expanded := sha512.Sum512(seed)
+	a, _ := new(edwards25519.Scalar).SetBytesWithClamping(expanded[:32])
+
+	nonce := sha512.Sum512(append([]byte("EIP-7980 divergence nonce"), seed...))
+	r, _ := new(edwards25519.Scalar).SetUniformBytes(nonce[:])
+	torsion, _ := new(edwards25519.Point).SetBytes(torsionPoint)
+	point := new(edwards25519.Point).ScalarBaseMult(r)
+	point.Add(point, torsion)
+
+	challenge := sha512.New()
+	challenge.Write(point.Bytes())
+	challenge.Write(publicKey)
+	challenge.Write(message)
+	k, _ := new(edwards25519.Scalar).SetUniformBytes(challenge.Sum(nil))
+
+	scalar := new(edwards25519.Scalar).MultiplyAdd(k, a, r)
+	return append(point.Bytes(), scalar.Bytes()...)
+}
+
+// WriteBenchstat writes reports in Go benchmark format, one line per backend
+//
+// Lines are named BenchmarkVerify/backend=<name>-<workers> and sorted by
+// name, so the output of several runs can be fed to benchstat directly.
+func WriteBenchstat(w io.Writer, reports map[string]BenchReport) error {
+	if w == nil {
+		return errNilWriter
+	}
+
+	names := make([]string, 0, len(reports))
+	for name := range reports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if _, err := fmt.Fprintf(w, "goos: %s\ngoarch: %s\npkg: github.com/EIPs-CodeLab/eip-7980\n", runtime.GOOS, runtime.GOARCH); err != nil {
+		return err
+	}
+	for _, name := range names {
+		report := reports[name]
+		var nsPerOp float64
+		if report.Ops > 0 {
+			nsPerOp = float64(report.Duration.Nanoseconds()) / float64(report.Ops)
+		}
+		if _, err := fmt.Fprintf(w, "BenchmarkVerify/backend=%s-%d\t%d\t%.1f ns/op\t%.2f allocs/op\n",
+			name, report.Workers, report.Ops, nsPerOp, report.AllocsPerOp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
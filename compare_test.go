@@ -0,0 +1,124 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/edwards25519"
+)
+
+// acceptAllBackend accepts every signature
+type acceptAllBackend struct{}
+
+func (acceptAllBackend) Verify(publicKey, message, signature []byte) bool { return true }
+
+// registerTestBackend registers backend for the duration of the test
+func registerTestBackend(t *testing.T, name string, backend Backend) {
+	t.Helper()
+	if err := RegisterBackend(name, backend); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		backendRegistry.Lock()
+		delete(backendRegistry.backends, name)
+		backendRegistry.Unlock()
+	})
+}
+
+// TestCompareBackends tests that every backend is measured and divergent backends are flagged
+func TestCompareBackends(t *testing.T) {
+	registerTestBackend(t, "wrapped", &stubBackend{result: true})
+	registerTestBackend(t, "accept-all", acceptAllBackend{})
+
+	reports, err := CompareBackends(BenchConfig{Duration: 20 * time.Millisecond, Workers: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 3 {
+		t.Fatalf("Expected 3 reports, got %d", len(reports))
+	}
+	for name, report := range reports {
+		if report.Backend != name || report.Mode != BenchModeVerifier || report.Ops == 0 {
+			t.Errorf("%s: unexpected report %+v", name, report)
+		}
+	}
+	if reports[DEFAULT_BACKEND_NAME].Diverged != 0 {
+		t.Errorf("Expected the reference not to diverge, got %d", reports[DEFAULT_BACKEND_NAME].Diverged)
+	}
+	if reports["accept-all"].Diverged == 0 || reports["wrapped"].Diverged == 0 {
+		t.Errorf("Expected accepting backends to diverge on tampered inputs")
+	}
+
+	var out bytes.Buffer
+	if err := WriteBenchstat(&out, reports); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 6 || !strings.HasPrefix(lines[3], "BenchmarkVerify/backend=accept-all-1\t") || !strings.HasSuffix(lines[5], " allocs/op") {
+		t.Errorf("Unexpected benchstat output:\n%s", out.String())
+	}
+}
+
+// cofactoredBackend verifies [8][S]B = [8]R + [8][k]A without canonicity or small-order checks
+type cofactoredBackend struct{}
+
+func (cofactoredBackend) Verify(publicKey, message, signature []byte) bool {
+	a, errA := new(edwards25519.Point).SetBytes(publicKey)
+	r, errR := new(edwards25519.Point).SetBytes(signature[:32])
+	if errA != nil || errR != nil {
+		return false
+	}
+	wide := make([]byte, 64)
+	copy(wide, signature[32:])
+	s, _ := new(edwards25519.Scalar).SetUniformBytes(wide)
+
+	challenge := sha512.New()
+	challenge.Write(signature[:32])
+	challenge.Write(publicKey)
+	challenge.Write(message)
+	k, _ := new(edwards25519.Scalar).SetUniformBytes(challenge.Sum(nil))
+
+	// [S]B - [k]A - R must be a torsion point
+	check := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(new(edwards25519.Scalar).Negate(k), a, s)
+	check.Subtract(check, r)
+	return isSmallOrder(check)
+}
+
+// TestBackendEdgeCases tests that the edge cases separate the reference from a cofactored verifier
+func TestBackendEdgeCases(t *testing.T) {
+	cases := backendEdgeCases()
+	if !(StdlibBackend{}).Verify(cases[0].publicKey, cases[0].message, cases[0].signature) {
+		t.Fatal("Expected the control case to verify")
+	}
+
+	// Valid signatures verify either way, so every disagreement is an edge case
+	if diverged := backendDivergence(cofactoredBackend{}, DeterministicVectors(4)); diverged < 4 {
+		t.Errorf("Expected the cofactored verifier to diverge on the edge cases, got %d", diverged)
+	}
+	if diverged := backendDivergence(StdlibBackend{}, DeterministicVectors(4)); diverged != 0 {
+		t.Errorf("Expected the reference not to diverge from itself, got %d", diverged)
+	}
+
+	for i, input := range cases {
+		if !(cofactoredBackend{}).Verify(input.publicKey, input.message, input.signature) {
+			t.Errorf("Case %d: expected the cofactored verifier to accept", i)
+		}
+	}
+}
+
+// TestRegisterBackendRejects tests duplicate and empty registrations
+func TestRegisterBackendRejects(t *testing.T) {
+	if err := RegisterBackend(DEFAULT_BACKEND_NAME, StdlibBackend{}); !errors.Is(err, ErrBackendRegistered) {
+		t.Errorf("Expected ErrBackendRegistered, got %v", err)
+	}
+	if err := RegisterBackend("", StdlibBackend{}); err == nil {
+		t.Errorf("Expected error for an empty name")
+	}
+	if err := RegisterBackend("nil", nil); err == nil {
+		t.Errorf("Expected error for a nil backend")
+	}
+}
@@ -0,0 +1,100 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+)
+
+// Signature component decoding failures; each wraps ErrInvalidSignature
+var (
+	ErrSignatureRNotOnCurve   = fmt.Errorf("%w: R is not a valid curve point", ErrInvalidSignature)
+	ErrSignatureRNonCanonical = fmt.Errorf("%w: non-canonical R encoding", ErrInvalidSignature)
+	ErrSignatureSNonCanonical = fmt.Errorf("%w: S is not a canonical scalar", ErrInvalidSignature)
+)
+
+// R returns the encoded commitment point, the first half of the signature
+//
+// A nil SignatureInfo returns zeros.
+func (s *SignatureInfo) R() [32]byte {
+	var r [32]byte
+	if s != nil {
+		copy(r[:], s.Signature[:32])
+	}
+	return r
+}
+
+// S returns the encoded scalar, the second half of the signature
+//
+// A nil SignatureInfo returns zeros.
+func (s *SignatureInfo) S() [32]byte {
+	var scalar [32]byte
+	if s != nil {
+		copy(scalar[:], s.Signature[32:])
+	}
+	return scalar
+}
+
+// DecodedR decodes R as a curve point
+//
+// Encodings that are off the curve fail with ErrSignatureRNotOnCurve and
+// non-canonical ones, which RFC 8032 decoding rejects, with
+// ErrSignatureRNonCanonical.
+func (s *SignatureInfo) DecodedR() (*edwards25519.Point, error) {
+	if s == nil {
+		return nil, errNilSignatureInfo
+	}
+
+	point := new(edwards25519.Point)
+	if err := decodeCanonicalPoint(point, s.Signature[:32], ErrSignatureRNotOnCurve, ErrSignatureRNonCanonical); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+// DecodedS decodes S as a scalar, failing with ErrSignatureSNonCanonical
+// unless it is below the group order as RFC 8032 requires
+func (s *SignatureInfo) DecodedS() (*edwards25519.Scalar, error) {
+	if s == nil {
+		return nil, errNilSignatureInfo
+	}
+
+	scalar, err := new(edwards25519.Scalar).SetCanonicalBytes(s.Signature[32:])
+	if err != nil {
+		return nil, ErrSignatureSNonCanonical
+	}
+	return scalar, nil
+}
+
+// DecodedPublicKey decodes the public key as a curve point with the checks Verify applies
+//
+// Failures are the ErrPublicKey errors returned by ValidatePublicKey, except
+// that small-order keys are decoded rather than rejected.
+func (s *SignatureInfo) DecodedPublicKey() (*edwards25519.Point, error) {
+	if s == nil {
+		return nil, errNilSignatureInfo
+	}
+
+	point := new(edwards25519.Point)
+	if err := decodePublicKey(point, s.PublicKey[:]); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+// AssembleSignatureInfo builds a SignatureInfo from decoded components
+//
+// Each component is written in its canonical encoding, so the result always
+// passes DecodedR, DecodedS and DecodedPublicKey. No component may be nil.
+func AssembleSignatureInfo(r *edwards25519.Point, s *edwards25519.Scalar, publicKey *edwards25519.Point) (*SignatureInfo, error) {
+	if r == nil || s == nil || publicKey == nil {
+		return nil, errors.New("signature components must not be nil")
+	}
+
+	info := &SignatureInfo{}
+	copy(info.Signature[:32], r.Bytes())
+	copy(info.Signature[32:], s.Bytes())
+	copy(info.PublicKey[:], publicKey.Bytes())
+	return info, nil
+}
@@ -0,0 +1,81 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+// TestSignatureComponentsRoundTrip tests that decoded components reassemble into the same verifying blob
+func TestSignatureComponentsRoundTrip(t *testing.T) {
+	for i, vector := range DeterministicVectors(8) {
+		info, err := ParseSignatureInfo(vector.SignatureInfo())
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, s := info.R(), info.S()
+		if !bytes.Equal(r[:], vector.Signature[:32]) || !bytes.Equal(s[:], vector.Signature[32:]) {
+			t.Fatalf("Vector %d: R or S does not match the signature halves", i)
+		}
+
+		decodedR, errR := info.DecodedR()
+		decodedS, errS := info.DecodedS()
+		publicKey, errKey := info.DecodedPublicKey()
+		if errR != nil || errS != nil || errKey != nil {
+			t.Fatalf("Vector %d: decode failed: %v, %v, %v", i, errR, errS, errKey)
+		}
+
+		assembled, err := AssembleSignatureInfo(decodedR, decodedS, publicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(assembled.ToBytes(), vector.SignatureInfo()) {
+			t.Errorf("Vector %d: assemble did not reproduce the input", i)
+		}
+		if address, err := Verify(assembled.ToBytes(), vector.PayloadHash); err != nil || address != vector.Address {
+			t.Errorf("Vector %d: expected %s, got %s, %v", i, vector.Address, address, err)
+		}
+	}
+
+	if _, err := AssembleSignatureInfo(nil, nil, nil); err == nil {
+		t.Errorf("Expected error for nil components")
+	}
+}
+
+// TestSignatureComponentsRejectNonCanonical tests the decode helpers on non-canonical encodings
+func TestSignatureComponentsRejectNonCanonical(t *testing.T) {
+	kat := KnownAnswerTests[0]
+
+	for name, encoding := range invalidPublicKeyVectors() {
+		info := NewSignatureInfoFromArrays(kat.Signature, kat.PublicKey)
+		copy(info.Signature[:32], encoding)
+		if _, err := info.DecodedR(); !errors.Is(err, ErrSignatureRNotOnCurve) && !errors.Is(err, ErrSignatureRNonCanonical) {
+			t.Errorf("R %s: expected an R decoding error, got %v", name, err)
+		}
+
+		copy(info.PublicKey[:], encoding)
+		if _, err := info.DecodedPublicKey(); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("Key %s: expected ErrInvalidPublicKey, got %v", name, err)
+		}
+	}
+
+	// The group order L and S + L both exceed the canonical range
+	order := [32]byte{0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14, 31: 0x10}
+	info := NewSignatureInfoFromArrays(kat.Signature, kat.PublicKey)
+	copy(info.Signature[32:], order[:])
+	if _, err := info.DecodedS(); !errors.Is(err, ErrSignatureSNonCanonical) || !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrSignatureSNonCanonical wrapping ErrInvalidSignature, got %v", err)
+	}
+	if ed25519.Verify(kat.PublicKey[:], kat.PayloadHash[:], info.Signature[:]) {
+		t.Errorf("Expected stdlib to reject S = L as well")
+	}
+
+	var nilInfo *SignatureInfo
+	if nilInfo.R() != ([32]byte{}) || nilInfo.S() != ([32]byte{}) {
+		t.Errorf("Expected zero components for nil SignatureInfo")
+	}
+	if _, err := nilInfo.DecodedR(); err == nil {
+		t.Errorf("Expected error decoding nil SignatureInfo")
+	}
+}
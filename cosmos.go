@@ -0,0 +1,221 @@
+package eip7980
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"unicode/utf16"
+)
+
+// ErrNotADR036 is returned when a sign doc is not a valid ADR-036 off-chain message
+var ErrNotADR036 = errors.New("sign doc is not an ADR-036 off-chain message")
+
+// canonicalInteger matches the only number form allowed in amino sign docs
+var canonicalInteger = regexp.MustCompile(`^(0|-?[1-9][0-9]*)$`)
+
+// VerifyADR036 verifies a Cosmos ADR-036 off-chain signature and maps the signer into EIP-7980
+//
+// The sign doc is canonicalized as amino JSON (see CanonicalAminoJSON) and
+// checked to be an ADR-036 document: empty chain_id, zero account_number and
+// sequence, empty fee and a single sign/MsgSignData message. Cosmos Ed25519
+// keys sign the canonical sign bytes directly, without a SHA-256 prehash (the
+// prehash is part of the secp256k1 scheme only), so the signature is verified
+// over the canonical bytes, through the configured Backend after the same
+// public key checks as Verify.
+//
+// Returns the keccak-derived EIP-7980 address of the same key, so dApps can
+// link the Cosmos and Ethereum identities.
+func VerifyADR036(signDocJSON []byte, sig [64]byte, pub [32]byte) (ExecutionAddress, error) {
+	signBytes, err := CanonicalAminoJSON(signDocJSON)
+	if err != nil {
+		return ExecutionAddress{}, err
+	}
+	if err := checkADR036(signBytes); err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	if err := validatePublicKey(pub[:]); err != nil {
+		return ExecutionAddress{}, err
+	}
+	if !verifyEd25519(currentBackend(), pub[:], signBytes, sig[:]) {
+		return ExecutionAddress{}, ErrInvalidSignature
+	}
+
+	return deriveAddress(pub[:]), nil
+}
+
+// adr036SignDoc is the subset of an amino StdSignDoc checked for ADR-036 conformance
+type adr036SignDoc struct {
+	AccountNumber string `json:"account_number"`
+	ChainID       string `json:"chain_id"`
+	Fee           struct {
+		Amount []json.RawMessage `json:"amount"`
+		Gas    string            `json:"gas"`
+	} `json:"fee"`
+	Memo string `json:"memo"`
+	Msgs []struct {
+		Type  string `json:"type"`
+		Value struct {
+			Data   string `json:"data"`
+			Signer string `json:"signer"`
+		} `json:"value"`
+	} `json:"msgs"`
+	Sequence string `json:"sequence"`
+}
+
+// checkADR036 enforces the fixed fields ADR-036 requires
+func checkADR036(signBytes []byte) error {
+	var doc adr036SignDoc
+	if err := json.Unmarshal(signBytes, &doc); err != nil {
+		return fmt.Errorf("%w: %v", ErrNotADR036, err)
+	}
+
+	switch {
+	case doc.ChainID != "":
+		return fmt.Errorf("%w: chain_id must be empty", ErrNotADR036)
+	case doc.AccountNumber != "0" || doc.Sequence != "0":
+		return fmt.Errorf("%w: account_number and sequence must be \"0\"", ErrNotADR036)
+	case len(doc.Fee.Amount) != 0 || doc.Fee.Gas != "0":
+		return fmt.Errorf("%w: fee must be empty", ErrNotADR036)
+	case len(doc.Msgs) != 1 || doc.Msgs[0].Type != "sign/MsgSignData":
+		return fmt.Errorf("%w: expected a single sign/MsgSignData message", ErrNotADR036)
+	case doc.Msgs[0].Value.Signer == "":
+		return fmt.Errorf("%w: missing signer", ErrNotADR036)
+	}
+	return nil
+}
+
+// CanonicalAminoJSON returns the amino sign bytes for a JSON document
+//
+// The rules match cosmjs serializeSignDoc: object keys sorted by UTF-16 code
+// units, no insignificant whitespace, strings escaped as JSON.stringify does
+// (only '"', '\\' and control characters), followed by escaping '&', '<' and
+// '>' as \u0026, \u003c and \u003e. Unicode escapes in the input are decoded
+// and re-emitted as raw UTF-8. Amino encodes integers as strings, so only
+// plain integer numbers are accepted; fractions and exponents are rejected
+// rather than reformatted.
+func CanonicalAminoJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid sign doc JSON: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("invalid sign doc JSON: trailing data")
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalAmino(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeCanonicalAmino serializes a decoded JSON value in amino canonical form
+func writeCanonicalAmino(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		if v {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+	case json.Number:
+		if !canonicalInteger.MatchString(string(v)) {
+			return fmt.Errorf("non-integer number %s in sign doc", v)
+		}
+		buf.WriteString(string(v))
+	case string:
+		writeJSONString(buf, v, true)
+	case []any:
+		buf.WriteByte('[')
+		for i, element := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalAmino(buf, element); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]any:
+		buf.WriteByte('{')
+		for i, key := range sortedKeysUTF16(v) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeJSONString(buf, key, true)
+			buf.WriteByte(':')
+			if err := writeCanonicalAmino(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unsupported JSON value %T", value)
+	}
+	return nil
+}
+
+// sortedKeysUTF16 returns the object keys ordered by UTF-16 code units, as JavaScript sorts them
+func sortedKeysUTF16(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessUTF16(keys[i], keys[j])
+	})
+	return keys
+}
+
+// lessUTF16 compares two strings by their UTF-16 encoding
+func lessUTF16(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+}
+
+// writeJSONString writes s as a JSON string with JSON.stringify escaping,
+// additionally escaping '&', '<' and '>' when escapeHTML is set
+func writeJSONString(buf *bytes.Buffer, s string, escapeHTML bool) {
+	const hexDigits = "0123456789abcdef"
+
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"':
+			buf.WriteString(`\"`)
+		case r == '\\':
+			buf.WriteString(`\\`)
+		case r == '\b':
+			buf.WriteString(`\b`)
+		case r == '\f':
+			buf.WriteString(`\f`)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case r < 0x20, escapeHTML && (r == '&' || r == '<' || r == '>'):
+			buf.WriteString(`\u00`)
+			buf.WriteByte(hexDigits[r>>4])
+			buf.WriteByte(hexDigits[r&0xf])
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+}
@@ -0,0 +1,116 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+// TestCanonicalAminoJSON tests canonicalization edge cases
+func TestCanonicalAminoJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"sorted keys", `{"b":1,"a":{"d":2,"c":3}}`, `{"a":{"c":3,"d":2},"b":1}`},
+		{"whitespace", "{ \"a\" :\n [ 1 , 2 ]\t}", `{"a":[1,2]}`},
+		{"unicode escape decoded", `{"a":"\u00e9"}`, `{"a":"é"}`},
+		{"html escaped", `{"a":"<b>&</b>"}`, `{"a":"\u003cb\u003e\u0026\u003c/b\u003e"}`},
+		{"control characters", `{"a":"\u0001\n"}`, `{"a":"\u0001\n"}`},
+		{"line separator kept raw", `{"a":"\u2028"}`, "{\"a\":\"\u2028\"}"},
+		{"escaped quote and backslash", `{"a":"\"\\"}`, `{"a":"\"\\"}`},
+		{"utf16 key order", `{"\uffff":2,"\ud83d\ude00":1}`, "{\"\U0001F600\":1,\"\uffff\":2}"},
+		{"literals", `[true,false,null,-12,0]`, `[true,false,null,-12,0]`},
+	}
+
+	for _, tt := range tests {
+		got, err := CanonicalAminoJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestCanonicalAminoJSONRejects tests inputs that have no canonical amino form
+func TestCanonicalAminoJSONRejects(t *testing.T) {
+	for _, input := range []string{`{"a":1.0}`, `{"a":1e2}`, `{"a":-0}`, `{"a":1} {}`, `{"a":`} {
+		if _, err := CanonicalAminoJSON([]byte(input)); err == nil {
+			t.Errorf("Expected error for %s", input)
+		}
+	}
+}
+
+// adr036TestDoc is an ADR-036 sign doc with deliberately unsorted keys and extra whitespace
+const adr036TestDoc = `{
+  "chain_id": "",
+  "account_number": "0",
+  "sequence": "0",
+  "fee": {"gas": "0", "amount": []},
+  "msgs": [{"type": "sign/MsgSignData", "value": {"signer": "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "data": "aGVsbG8gPHdvcmxkPg=="}}],
+  "memo": ""
+}`
+
+// TestVerifyADR036 tests verification over the canonical sign bytes
+func TestVerifyADR036(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signBytes, err := CanonicalAminoJSON([]byte(adr036TestDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sig [64]byte
+	var pub [32]byte
+	copy(sig[:], ed25519.Sign(privateKey, signBytes))
+	copy(pub[:], publicKey)
+
+	address, err := VerifyADR036([]byte(adr036TestDoc), sig, pub)
+	if err != nil {
+		t.Fatalf("VerifyADR036 failed: %v", err)
+	}
+	if address != deriveAddress(publicKey) {
+		t.Errorf("Address = %s, want %s", address, deriveAddress(publicKey))
+	}
+
+	// Keys are validated like Verify's and signatures go through the Backend
+	for name, invalid := range invalidPublicKeyVectors() {
+		var badKey [32]byte
+		copy(badKey[:], invalid)
+		if _, err := VerifyADR036([]byte(adr036TestDoc), sig, badKey); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%s: expected ErrInvalidPublicKey, got %v", name, err)
+		}
+	}
+	backend := installCountingBackend(t)
+	if _, err := VerifyADR036([]byte(adr036TestDoc), sig, pub); err != nil || backend.calls != 1 {
+		t.Errorf("Expected one Backend call, got %d, %v", backend.calls, err)
+	}
+
+	sig[0] ^= 0x01
+	if _, err := VerifyADR036([]byte(adr036TestDoc), sig, pub); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+// TestVerifyADR036RejectsNonADR036 tests that transaction sign docs are not accepted
+func TestVerifyADR036RejectsNonADR036(t *testing.T) {
+	docs := []string{
+		`{"account_number":"0","chain_id":"cosmoshub-4","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"","signer":"x"}}],"sequence":"0"}`,
+		`{"account_number":"7","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"","signer":"x"}}],"sequence":"0"}`,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"cosmos-sdk/MsgSend","value":{}}],"sequence":"0"}`,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[],"sequence":"0"}`,
+	}
+
+	for i, doc := range docs {
+		if _, err := VerifyADR036([]byte(doc), [64]byte{}, [32]byte{}); !errors.Is(err, ErrNotADR036) {
+			t.Errorf("Doc %d: expected ErrNotADR036, got %v", i, err)
+		}
+	}
+}
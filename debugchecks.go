@@ -0,0 +1,47 @@
+package eip7980
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// debugStackSize bounds the goroutine dump included in a mutation panic
+const debugStackSize = 1 << 20
+
+// snapshotParts copies a 64-byte signature and 32-byte public key in signature_info layout
+func snapshotParts(signature, publicKey []byte) [MAX_SIZE]byte {
+	var snapshot [MAX_SIZE]byte
+	copy(snapshot[:64], signature)
+	copy(snapshot[64:], publicKey)
+	return snapshot
+}
+
+// checkUnmodified panics if signature and publicKey no longer match snapshot
+//
+// verifyParts defers it when built with the eip7980_debugchecks tag, so every
+// entry point that reads a caller's slices is covered, to catch callers that
+// write to the buffer while verification reads it in place. The panic names
+// the changed byte range, in signature_info offsets, and includes a dump of
+// all goroutines, since the writer is usually another goroutine. payloadHash
+// is passed by value and cannot change, so only the slices are checked.
+func checkUnmodified(signature, publicKey []byte, snapshot [MAX_SIZE]byte) {
+	current := snapshotParts(signature, publicKey)
+
+	first, last := -1, -1
+	for i := range snapshot {
+		if current[i] != snapshot[i] {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return
+	}
+
+	stack := make([]byte, debugStackSize)
+	stack = stack[:runtime.Stack(stack, true)]
+	panic(fmt.Sprintf("eip7980: signature_info was modified during verification (bytes %d..%d changed); "+
+		"callers must not write to it until the verify call returns\n\n%s", first, last, stack))
+}
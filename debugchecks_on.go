@@ -0,0 +1,6 @@
+//go:build eip7980_debugchecks
+
+package eip7980
+
+// debugChecks makes verification panic if the caller's input changes during the call
+const debugChecks = true
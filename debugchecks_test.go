@@ -0,0 +1,71 @@
+//go:build eip7980_debugchecks
+
+package eip7980
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// mutatingBackend simulates a slow backend during which another goroutine writes to the input
+type mutatingBackend struct {
+	buffer []byte
+}
+
+func (m mutatingBackend) Verify(publicKey, message, signature []byte) bool {
+	done := make(chan struct{})
+	go func() {
+		m.buffer[70] ^= 0xff
+		close(done)
+	}()
+	<-done
+	time.Sleep(time.Millisecond)
+	return true
+}
+
+// TestVerifyDetectsMutation tests that a buffer changed mid-verification panics with a diagnosis at every entry point
+func TestVerifyDetectsMutation(t *testing.T) {
+	payloadHash := [32]byte{0x12}
+
+	for name, verify := range map[string]func(signatureInfo []byte, backend Backend) error{
+		"Verifier.Verify": func(signatureInfo []byte, backend Backend) error {
+			_, err := (&Verifier{Backend: backend}).Verify(signatureInfo, payloadHash)
+			return err
+		},
+		"Verify": func(signatureInfo []byte, backend Backend) error {
+			SetBackend(backend)
+			defer SetBackend(nil)
+			_, err := Verify(signatureInfo, payloadHash)
+			return err
+		},
+		"VerifyStrict": func(signatureInfo []byte, backend Backend) error {
+			SetBackend(backend)
+			defer SetBackend(nil)
+			_, err := VerifyStrict(signatureInfo, payloadHash)
+			return err
+		},
+		"VerifySplit": func(signatureInfo []byte, backend Backend) error {
+			SetBackend(backend)
+			defer SetBackend(nil)
+			_, err := VerifySplit(signatureInfo[:64], signatureInfo[64:], payloadHash)
+			return err
+		},
+	} {
+		signatureInfo := newTestSignatureInfo(t, payloadHash)
+		if err := verify(signatureInfo, nil); err != nil {
+			t.Fatalf("%s: unmodified input failed: %v", name, err)
+		}
+
+		message := func() (message string) {
+			defer func() { message, _ = recover().(string) }()
+			_ = verify(signatureInfo, mutatingBackend{buffer: signatureInfo})
+			return ""
+		}()
+		for _, want := range []string{"modified during verification", "bytes 70..70", "goroutine "} {
+			if !strings.Contains(message, want) {
+				t.Errorf("%s: expected panic containing %q, got %q", name, want, message)
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package eip7980
+
+import (
+	"encoding/json"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// allowedModules are the only non-stdlib modules the library may depend on.
+// golang.org/x/sys is pulled in by golang.org/x/crypto for CPU feature detection.
+var allowedModules = map[string]bool{
+	"github.com/EIPs-CodeLab/eip-7980": true,
+	"golang.org/x/crypto":              true,
+	"golang.org/x/sys":                 true,
+	"filippo.io/edwards25519":          true,
+}
+
+// TestImportGraph asserts that importing the library does not pull in CLI,
+// server or other heavyweight dependencies
+func TestImportGraph(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not available")
+	}
+
+	out, err := exec.Command(goTool, "list", "-deps", "-json", ".").Output()
+	if err != nil {
+		t.Fatalf("go list failed: %v", err)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(out)))
+	for {
+		var pkg struct {
+			ImportPath string
+			Standard   bool
+			Module     *struct{ Path string }
+		}
+		if err := decoder.Decode(&pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+
+		if pkg.Standard {
+			continue
+		}
+		if pkg.Module == nil || !allowedModules[pkg.Module.Path] {
+			t.Errorf("Library depends on disallowed package %s", pkg.ImportPath)
+		}
+		if strings.HasPrefix(pkg.ImportPath, "github.com/EIPs-CodeLab/eip-7980/") {
+			t.Errorf("Library imports subpackage %s", pkg.ImportPath)
+		}
+	}
+}
+
+// TestModuleRequirements asserts that go.mod requires only allowed modules
+//
+// TestImportGraph checks the packages built with default tags; this also
+// catches a dependency that only tagged files or tests use, which every
+// consumer would still find in its module graph.
+func TestModuleRequirements(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not available")
+	}
+
+	out, err := exec.Command(goTool, "mod", "edit", "-json").Output()
+	if err != nil {
+		t.Fatalf("go mod edit failed: %v", err)
+	}
+
+	var modFile struct {
+		Require []struct{ Path string }
+	}
+	if err := json.Unmarshal(out, &modFile); err != nil {
+		t.Fatal(err)
+	}
+	for _, require := range modFile.Require {
+		if !allowedModules[require.Path] {
+			t.Errorf("go.mod requires disallowed module %s", require.Path)
+		}
+	}
+}
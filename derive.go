@@ -0,0 +1,78 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// DeriveAddress derives the execution address of an Ed25519 public key
+//
+// Returns an error wrapping ErrInvalidPublicKey unless publicKey passes
+// ValidatePublicKey; any other input would otherwise hash to a well-formed
+// but meaningless address.
+func DeriveAddress(publicKey []byte) (ExecutionAddress, error) {
+	if err := ValidatePublicKey(publicKey); err != nil {
+		return ExecutionAddress{}, err
+	}
+	return deriveAddress(publicKey), nil
+}
+
+// DeriveAddressNetworked derives an address salted with a network byte:
+// the last 20 bytes of keccak256(networkByte || publicKey)
+//
+// This is a non-standard extension, not part of EIP-7980. It lets a chain
+// keep the same key from mapping to the same address on other networks, but
+// the result never matches DeriveAddress, and no other EIP-7980
+// implementation will recognize it. publicKey must pass ValidatePublicKey.
+func DeriveAddressNetworked(publicKey []byte, networkByte byte) (ExecutionAddress, error) {
+	if err := ValidatePublicKey(publicKey); err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte{networkByte})
+	hash.Write(publicKey)
+	fullHash := hash.Sum(nil)
+
+	var address ExecutionAddress
+	copy(address[:], fullHash[len(fullHash)-20:])
+	return address, nil
+}
+
+// AssertKeyAddress checks that addr is the address derived from pub
+//
+// Returns an error wrapping ErrInvalidPublicKey if pub fails
+// ValidatePublicKey, or ErrAddressMismatch if it derives a different address.
+func AssertKeyAddress(pub ed25519.PublicKey, addr ExecutionAddress) error {
+	derived, err := DeriveAddress(pub)
+	if err != nil {
+		return err
+	}
+	if derived != addr {
+		return fmt.Errorf("%w: key derives %s, stored %s", ErrAddressMismatch, derived, addr)
+	}
+	return nil
+}
+
+// DeriveAddressMap derives the address of every public key, keyed by PublicKeyFingerprint
+//
+// Intended for wallet account scanning over a sequence of derived keys.
+// Returns an error naming the index of the first malformed key; duplicate
+// keys collapse into a single entry.
+func DeriveAddressMap(pubKeys [][]byte) (map[string]ExecutionAddress, error) {
+	addresses := make(map[string]ExecutionAddress, len(pubKeys))
+	for i, publicKey := range pubKeys {
+		fingerprint, err := PublicKeyFingerprint(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("public key %d: %w", i, err)
+		}
+		address, err := DeriveAddress(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("public key %d: %w", i, err)
+		}
+		addresses[fingerprint] = address
+	}
+	return addresses, nil
+}
@@ -0,0 +1,122 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestDeriveAddressMap tests batch derivation keyed by fingerprint
+func TestDeriveAddressMap(t *testing.T) {
+	var pubKeys [][]byte
+	for i := 0; i < 3; i++ {
+		publicKey, _, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, publicKey)
+	}
+
+	addresses, err := DeriveAddressMap(pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) != len(pubKeys) {
+		t.Fatalf("Got %d entries, want %d", len(addresses), len(pubKeys))
+	}
+	for _, publicKey := range pubKeys {
+		fingerprint, _ := PublicKeyFingerprint(publicKey)
+		if addresses[fingerprint] != deriveAddress(publicKey) {
+			t.Errorf("Wrong address for key %s", fingerprint)
+		}
+	}
+
+	pubKeys = append(pubKeys, make([]byte, 16), make([]byte, 33))
+	_, err = DeriveAddressMap(pubKeys)
+	if !errors.Is(err, ErrInvalidPublicKey) || !strings.Contains(err.Error(), "public key 3") {
+		t.Errorf("Expected error naming key 3, got %v", err)
+	}
+}
+
+// TestDeriveAddressLength tests that wrong-length keys are rejected
+func TestDeriveAddressLength(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := DeriveAddress(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != deriveAddress(publicKey) {
+		t.Errorf("DeriveAddress disagrees with deriveAddress")
+	}
+
+	for _, size := range []int{0, 16, 64} {
+		address, err := DeriveAddress(make([]byte, size))
+		if !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%d-byte key: expected ErrInvalidPublicKey, got %v", size, err)
+		}
+		if address != (ExecutionAddress{}) {
+			t.Errorf("%d-byte key: expected zero address, got %x", size, address)
+		}
+		if _, err := DeriveAddressMap([][]byte{make([]byte, size)}); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%d-byte key: DeriveAddressMap expected ErrInvalidPublicKey, got %v", size, err)
+		}
+	}
+}
+
+// TestAssertKeyAddress tests matching, mismatching and invalid key/address pairs
+func TestAssertKeyAddress(t *testing.T) {
+	kat := KnownAnswerTests[0]
+	publicKey := ed25519.PublicKey(kat.PublicKey[:])
+
+	if err := AssertKeyAddress(publicKey, kat.Address); err != nil {
+		t.Errorf("Matching pair rejected: %v", err)
+	}
+
+	other := kat.Address
+	other[0] ^= 0xff
+	if err := AssertKeyAddress(publicKey, other); !errors.Is(err, ErrAddressMismatch) {
+		t.Errorf("Expected ErrAddressMismatch, got %v", err)
+	}
+
+	if err := AssertKeyAddress(publicKey[:16], kat.Address); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("Expected ErrInvalidPublicKey, got %v", err)
+	}
+}
+
+// TestDeriveAddressNetworked tests that network bytes separate addresses for the same key
+func TestDeriveAddressNetworked(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[ExecutionAddress]byte)
+	standard := deriveAddress(publicKey)
+	for _, network := range []byte{0x00, 0x01, 0x02, 0xff} {
+		address, err := DeriveAddressNetworked(publicKey, network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if other, ok := seen[address]; ok {
+			t.Errorf("Network bytes %#x and %#x derive the same address %s", other, network, address)
+		}
+		if address == standard {
+			t.Errorf("Network byte %#x derives the standard address", network)
+		}
+		seen[address] = network
+
+		again, _ := DeriveAddressNetworked(publicKey, network)
+		if again != address {
+			t.Errorf("Network byte %#x: derivation is not deterministic", network)
+		}
+	}
+
+	if _, err := DeriveAddressNetworked(publicKey[:31], 0x01); !errors.Is(err, ErrPublicKeyLength) {
+		t.Errorf("Expected ErrPublicKeyLength, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package eip7980
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffRowSize is the number of bytes per row of the DiffReport hex dump;
+// it divides the 32-byte field size so rows never straddle fields
+const diffRowSize = 8
+
+// DiffRange is a run of differing bytes within one semantic field
+type DiffRange struct {
+	Field string // "R", "S", "publicKey", or "trailing" beyond MAX_SIZE
+	Start int    // First differing offset
+	End   int    // Offset after the last differing byte
+}
+
+// DiffReport aligns two signature_info blobs byte by byte
+//
+// Bytes present in only one blob count as differing. Ranges never cross a
+// field boundary, so each maps to exactly one of R, S or publicKey.
+type DiffReport struct {
+	A      []byte
+	B      []byte
+	Ranges []DiffRange
+}
+
+// DiffSignatureInfo compares two signature_info encodings, which need not be valid or equal length
+func DiffSignatureInfo(a, b []byte) DiffReport {
+	report := DiffReport{
+		A: append([]byte(nil), a...),
+		B: append([]byte(nil), b...),
+	}
+
+	for offset := 0; offset < max(len(a), len(b)); offset++ {
+		if offset < len(a) && offset < len(b) && a[offset] == b[offset] {
+			continue
+		}
+
+		field := signatureInfoField(offset)
+		last := len(report.Ranges) - 1
+		if last >= 0 && report.Ranges[last].End == offset && report.Ranges[last].Field == field {
+			report.Ranges[last].End++
+			continue
+		}
+		report.Ranges = append(report.Ranges, DiffRange{Field: field, Start: offset, End: offset + 1})
+	}
+
+	return report
+}
+
+// signatureInfoField names the field holding offset in the 96-byte layout
+func signatureInfoField(offset int) string {
+	switch {
+	case offset < 32:
+		return "R"
+	case offset < 64:
+		return "S"
+	case offset < MAX_SIZE:
+		return "publicKey"
+	default:
+		return "trailing"
+	}
+}
+
+// Equal reports whether the two blobs are identical
+func (r DiffReport) Equal() bool {
+	return len(r.Ranges) == 0
+}
+
+// String renders a summary line per range and a two-column annotated hex dump
+//
+// Bytes missing from the shorter blob are shown as "--"; rows containing a
+// difference are marked with "<".
+func (r DiffReport) String() string {
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "a: %d bytes, b: %d bytes\n", len(r.A), len(r.B))
+	if r.Equal() {
+		out.WriteString("identical\n")
+	}
+	for _, rng := range r.Ranges {
+		fmt.Fprintf(&out, "differs: %s bytes %d..%d\n", rng.Field, rng.Start, rng.End-1)
+	}
+
+	for row := 0; row < max(len(r.A), len(r.B)); row += diffRowSize {
+		marker := ""
+		for _, rng := range r.Ranges {
+			if rng.Start < row+diffRowSize && rng.End > row {
+				marker = " <"
+				break
+			}
+		}
+		fmt.Fprintf(&out, "%04x %-9s  %s  %s%s\n", row, signatureInfoField(row), dumpRow(r.A, row), dumpRow(r.B, row), marker)
+	}
+
+	return out.String()
+}
+
+// dumpRow formats diffRowSize bytes of data from offset, padding missing bytes with "--"
+func dumpRow(data []byte, offset int) string {
+	cells := make([]string, diffRowSize)
+	for i := range cells {
+		if offset+i < len(data) {
+			cells[i] = fmt.Sprintf("%02x", data[offset+i])
+		} else {
+			cells[i] = "--"
+		}
+	}
+	return strings.Join(cells, " ")
+}
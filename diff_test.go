@@ -0,0 +1,77 @@
+package eip7980
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestDiffSignatureInfoIdentical tests that identical blobs produce no ranges
+func TestDiffSignatureInfoIdentical(t *testing.T) {
+	signatureInfo := newTestSignatureInfo(t, [32]byte{})
+
+	report := DiffSignatureInfo(signatureInfo, signatureInfo)
+	if !report.Equal() {
+		t.Errorf("Expected no differences, got %+v", report.Ranges)
+	}
+	if !strings.Contains(report.String(), "identical") || strings.Contains(report.String(), "<") {
+		t.Errorf("Unexpected dump:\n%s", report)
+	}
+}
+
+// TestDiffSignatureInfoFields tests that a single-byte change is attributed to the right field
+func TestDiffSignatureInfoFields(t *testing.T) {
+	signatureInfo := newTestSignatureInfo(t, [32]byte{})
+
+	for _, tc := range []struct {
+		offset int
+		field  string
+	}{
+		{0, "R"},
+		{31, "R"},
+		{32, "S"},
+		{63, "S"},
+		{64, "publicKey"},
+		{95, "publicKey"},
+	} {
+		changed := append([]byte(nil), signatureInfo...)
+		changed[tc.offset] ^= 0x01
+
+		report := DiffSignatureInfo(signatureInfo, changed)
+		want := DiffRange{Field: tc.field, Start: tc.offset, End: tc.offset + 1}
+		if len(report.Ranges) != 1 || report.Ranges[0] != want {
+			t.Errorf("Offset %d: expected %+v, got %+v", tc.offset, want, report.Ranges)
+		}
+	}
+}
+
+// TestDiffSignatureInfoSplitsAtFieldBoundary tests that a run crossing R and S is reported per field
+func TestDiffSignatureInfoSplitsAtFieldBoundary(t *testing.T) {
+	a := make([]byte, MAX_SIZE)
+	b := make([]byte, MAX_SIZE)
+	for i := 30; i < 34; i++ {
+		b[i] = 0xff
+	}
+
+	report := DiffSignatureInfo(a, b)
+	want := []DiffRange{{"R", 30, 32}, {"S", 32, 34}}
+	if len(report.Ranges) != 2 || report.Ranges[0] != want[0] || report.Ranges[1] != want[1] {
+		t.Errorf("Expected %+v, got %+v", want, report.Ranges)
+	}
+}
+
+// TestDiffSignatureInfoLengths tests blobs of different lengths
+func TestDiffSignatureInfoLengths(t *testing.T) {
+	signatureInfo := newTestSignatureInfo(t, [32]byte{})
+	long := append(append([]byte(nil), signatureInfo...), 0xaa, 0xbb)
+
+	report := DiffSignatureInfo(signatureInfo[:90], long)
+	want := []DiffRange{{"publicKey", 90, 96}, {"trailing", 96, 98}}
+	if len(report.Ranges) != 2 || report.Ranges[0] != want[0] || report.Ranges[1] != want[1] {
+		t.Errorf("Expected %+v, got %+v", want, report.Ranges)
+	}
+
+	dump := report.String()
+	if !strings.Contains(dump, "a: 90 bytes, b: 98 bytes") || !strings.Contains(dump, "-- --") {
+		t.Errorf("Unexpected dump:\n%s", dump)
+	}
+}
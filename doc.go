@@ -32,6 +32,26 @@ specification including:
   - Ethereum address derivation from Ed25519 public keys
   - Full test coverage and benchmarks
 
+Initialization:
+
+Package-level tables (the default reserved precompile set, the known-answer
+vectors and the embedded report templates) are built eagerly by package
+variable initializers, which the Go runtime runs exactly once before any
+caller can reach them. Nothing is computed lazily on first use, so the
+first calls from many goroutines are race-free without locks. New tables
+should follow the same pattern; if one is ever too costly to build
+eagerly, initialize it behind a sync.Once.
+
+Debug checks:
+
+Building with -tags eip7980_debugchecks makes verification snapshot the
+caller's signature and public key bytes on entry and panic, with a goroutine
+dump, if they changed by the time it returns. The check sits in the helper
+every entry point verifies through, so it covers Verify, VerifyStrict,
+VerifySplit, Verifier.Verify and every function built on them; entry points
+that take the signature and key as arrays receive copies and cannot be
+affected. Normal builds compile the check out entirely.
+
 Usage:
 
 	signatureInfo := make([]byte, 96) // 64-byte signature + 32-byte public key
@@ -46,4 +66,4 @@ References:
   - EIP-7980: https://eips.ethereum.org/EIPS/eip-7980
   - RFC 8032: https://datatracker.ietf.org/doc/html/rfc8032
 */
-package main
+package eip7980
@@ -0,0 +1,259 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// EIP-7980 Constants
+const (
+	ALG_TYPE    = byte(0x00) // Algorithm type identifier
+	GAS_PENALTY = 1000       // Additional gas cost for Ed25519 verification
+	MAX_SIZE    = 96         // Total size: 64 bytes signature + 32 bytes public key
+)
+
+// Verification errors
+var (
+	ErrInvalidSignature = errors.New("ed25519 signature verification failed")
+	ErrInvalidPublicKey = errors.New("invalid ed25519 public key")
+)
+
+// SignatureInfo represents the 96-byte signature data structure
+type SignatureInfo struct {
+	Signature [64]byte // Ed25519 signature (R + S)
+	PublicKey [32]byte // Ed25519 public key
+}
+
+// ExecutionAddress represents a 20-byte Ethereum address
+type ExecutionAddress [20]byte
+
+// Verify implements the EIP-7980 signature verification algorithm
+// This function verifies an Ed25519 signature and derives the Ethereum address
+//
+// Parameters:
+//   - signatureInfo: 96 bytes (64-byte signature + 32-byte public key)
+//   - payloadHash: 32-byte hash of the transaction payload
+//
+// Returns:
+//   - ExecutionAddress: 20-byte Ethereum address derived from the public key
+//   - error: verification error if signature is invalid
+//
+// Verify reads signatureInfo in place without copying it. The caller must not
+// modify the buffer until Verify returns; concurrent mutation can produce torn
+// reads and nondeterministic results.
+func Verify(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	publicKey, err := verifySignatureInfo(signatureInfo, payloadHash, verifyOptions{backend: currentBackend()})
+	if err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	// Derive Ethereum address from public key using Keccak256
+	// Take the last 20 bytes of keccak256(public_key)
+	address := deriveAddress(publicKey)
+
+	return address, nil
+}
+
+// VerifyKeyOnly verifies signature_info like Verify but skips address derivation
+//
+// Use it on code paths keyed by public key rather than address. The returned
+// key is a copy, so it stays valid if the caller reuses signatureInfo.
+func VerifyKeyOnly(signatureInfo []byte, payloadHash [32]byte) (ed25519.PublicKey, error) {
+	publicKey, err := verifySignatureInfo(signatureInfo, payloadHash, verifyOptions{backend: currentBackend()})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ed25519.PublicKey(nil), publicKey...), nil
+}
+
+// VerifySplit verifies a signature and public key held as separate slices
+//
+// It is equivalent to Verify on their 96-byte concatenation but avoids
+// building the packed blob. signature must be 64 bytes and publicKey 32.
+func VerifySplit(signature []byte, publicKey []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	if len(signature) != ed25519.SignatureSize {
+		return ExecutionAddress{}, fmt.Errorf("invalid signature length: expected %d, got %d", ed25519.SignatureSize, len(signature))
+	}
+
+	// validatePublicKey rejects keys that are not 32 bytes with ErrPublicKeyLength
+	if err := verifyParts(signature, publicKey, payloadHash, verifyOptions{backend: currentBackend()}); err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	return deriveAddress(publicKey), nil
+}
+
+// verifyOptions selects how verifySignatureInfo checks a signature
+type verifyOptions struct {
+	backend Backend // Signature check; nil means crypto/ed25519
+	strict  bool    // Apply ValidatePublicKey, rejecting small-order keys too
+
+	// observe, if set, is called after each stage with its name and result
+	observe func(stage string, err error)
+}
+
+// Stage names passed to verifyOptions.observe, in the order they run
+const (
+	stageLength    = "length"
+	stagePublicKey = "public key"
+	stageSignature = "signature"
+)
+
+// done reports a finished stage to observe and passes err through
+func (opts verifyOptions) done(stage string, err error) error {
+	if opts.observe != nil {
+		opts.observe(stage, err)
+	}
+	return err
+}
+
+// verifySignatureInfo runs the checks every signature_info entry point shares
+//
+// It checks the length, splits signatureInfo into signature and public key,
+// and verifies them with verifyParts. On success it returns the public key,
+// which aliases signatureInfo; callers derive the address they need from it.
+func verifySignatureInfo(signatureInfo []byte, payloadHash [32]byte, opts verifyOptions) ([]byte, error) {
+	// Validate signature_info length (MUST be exactly 96 bytes)
+	if len(signatureInfo) != MAX_SIZE {
+		return nil, opts.done(stageLength, fmt.Errorf("invalid signature info length: expected %d, got %d", MAX_SIZE, len(signatureInfo)))
+	}
+	opts.done(stageLength, nil)
+
+	// Split signature_info into signature (first 64 bytes) and public key (last 32 bytes)
+	signature := signatureInfo[:64]
+	publicKey := signatureInfo[64:96]
+
+	if err := verifyParts(signature, publicKey, payloadHash, opts); err != nil {
+		return nil, err
+	}
+	return publicKey, nil
+}
+
+// verifyParts validates publicKey and verifies signature over payloadHash
+//
+// Built with the eip7980_debugchecks tag, it snapshots both slices on entry
+// and panics if either changed by the time it returns.
+func verifyParts(signature, publicKey []byte, payloadHash [32]byte, opts verifyOptions) error {
+	if debugChecks && len(signature) == ed25519.SignatureSize && len(publicKey) == ed25519.PublicKeySize {
+		defer checkUnmodified(signature, publicKey, snapshotParts(signature, publicKey))
+	}
+
+	// Reject public keys that are not canonical encodings of curve points
+	validate := validatePublicKey
+	if opts.strict {
+		validate = ValidatePublicKey
+	}
+	if err := opts.done(stagePublicKey, validate(publicKey)); err != nil {
+		return err
+	}
+
+	// Verify Ed25519 signature according to RFC 8032 Section 5.1.7
+	// This MUST be processed as raw Ed25519 (not Ed25519ctx or Ed25519ph)
+	if !verifyEd25519(opts.backend, publicKey, payloadHash[:], signature) {
+		return opts.done(stageSignature, ErrInvalidSignature)
+	}
+	return opts.done(stageSignature, nil)
+}
+
+// VerifyFromTuple verifies a signature held as a decoded two-element tuple
+// [signature (64 bytes), publicKey (32 bytes)], as found in RLP-encoded
+// structured transaction fields
+func VerifyFromTuple(tuple [][]byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	if len(tuple) != 2 {
+		return ExecutionAddress{}, fmt.Errorf("invalid signature tuple: expected 2 elements [signature, publicKey], got %d", len(tuple))
+	}
+	if len(tuple[0]) != ed25519.SignatureSize {
+		return ExecutionAddress{}, fmt.Errorf("invalid signature tuple: element 0 (signature) must be %d bytes, got %d", ed25519.SignatureSize, len(tuple[0]))
+	}
+	if len(tuple[1]) != ed25519.PublicKeySize {
+		return ExecutionAddress{}, fmt.Errorf("invalid signature tuple: element 1 (publicKey) must be %d bytes, got %d", ed25519.PublicKeySize, len(tuple[1]))
+	}
+
+	return VerifySplit(tuple[0], tuple[1], payloadHash)
+}
+
+// deriveAddress derives an Ethereum address from an Ed25519 public key
+// Returns the last 20 bytes of keccak256(publicKey); callers must pass a
+// 32-byte key, exported entry points use DeriveAddress to check that
+func deriveAddress(publicKey []byte) ExecutionAddress {
+	// Compute Keccak256 hash of the public key
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(publicKey)
+	fullHash := hash.Sum(nil)
+
+	// Extract last 20 bytes as Ethereum address
+	var address ExecutionAddress
+	copy(address[:], fullHash[len(fullHash)-20:])
+
+	return address
+}
+
+// ParseSignatureInfo converts raw bytes into structured SignatureInfo
+// The result holds its own copy of data, so the caller may reuse the buffer
+func ParseSignatureInfo(data []byte) (*SignatureInfo, error) {
+	if len(data) != MAX_SIZE {
+		return nil, fmt.Errorf("invalid data length: expected %d, got %d", MAX_SIZE, len(data))
+	}
+
+	sigInfo := &SignatureInfo{}
+	copy(sigInfo.Signature[:], data[:64])
+	copy(sigInfo.PublicKey[:], data[64:96])
+
+	return sigInfo, nil
+}
+
+// NewSignatureInfoFromArrays builds a SignatureInfo from fixed-size components
+// It cannot fail: the array types guarantee the lengths
+func NewSignatureInfoFromArrays(sig [64]byte, key [32]byte) *SignatureInfo {
+	return &SignatureInfo{
+		Signature: sig,
+		PublicKey: key,
+	}
+}
+
+// ToBytes converts SignatureInfo to raw bytes; a nil SignatureInfo returns nil
+func (s *SignatureInfo) ToBytes() []byte {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]byte, MAX_SIZE)
+	copy(result[:64], s.Signature[:])
+	copy(result[64:], s.PublicKey[:])
+	return result
+}
+
+// Clone returns a deep copy of s; a nil SignatureInfo returns nil
+//
+// The fields are value arrays today, so this is a plain copy, but callers
+// sharing a SignatureInfo across goroutines should use Clone so they stay
+// safe if the struct gains reference fields.
+func (s *SignatureInfo) Clone() *SignatureInfo {
+	if s == nil {
+		return nil
+	}
+
+	clone := *s
+	return &clone
+}
+
+// MatchesAddress reports whether s's public key derives addr
+//
+// It is a cheap pre-check for rejecting signatures from an unexpected
+// sender before verification; it does not verify the signature or validate
+// the key. A nil SignatureInfo matches nothing.
+func (s *SignatureInfo) MatchesAddress(addr ExecutionAddress) bool {
+	if s == nil {
+		return false
+	}
+	return deriveAddress(s.PublicKey[:]) == addr
+}
+
+// String returns hex representation of the address
+func (addr ExecutionAddress) String() string {
+	return fmt.Sprintf("0x%x", addr[:])
+}
@@ -0,0 +1,245 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+// TestNewSignatureInfoFromArrays tests the array constructor against ToBytes/ParseSignatureInfo
+func TestNewSignatureInfoFromArrays(t *testing.T) {
+	var sig [64]byte
+	var key [32]byte
+	for i := range sig {
+		sig[i] = byte(i)
+	}
+	for i := range key {
+		key[i] = byte(0xff - i)
+	}
+
+	sigInfo := NewSignatureInfoFromArrays(sig, key)
+
+	data := sigInfo.ToBytes()
+	if !bytes.Equal(data[:64], sig[:]) || !bytes.Equal(data[64:], key[:]) {
+		t.Fatalf("ToBytes layout mismatch")
+	}
+
+	parsed, err := ParseSignatureInfo(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *parsed != *sigInfo {
+		t.Errorf("Round trip changed signature info")
+	}
+}
+
+// TestSignatureInfoToBytesRoundTrip tests that ParseSignatureInfo accepts every ToBytes output unchanged
+//
+// FuzzSignatureInfo covers the bytes -> struct -> bytes direction; this
+// covers struct -> bytes -> struct for arbitrary signatures and keys.
+func TestSignatureInfoToBytesRoundTrip(t *testing.T) {
+	roundTrip := func(sig [64]byte, key [32]byte) bool {
+		sigInfo := NewSignatureInfoFromArrays(sig, key)
+		data := sigInfo.ToBytes()
+		if len(data) != MAX_SIZE {
+			return false
+		}
+
+		parsed, err := ParseSignatureInfo(data)
+		return err == nil && parsed.Signature == sig && parsed.PublicKey == key
+	}
+
+	if err := quick.Check(roundTrip, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestSignatureInfoClone tests that mutating a clone leaves the original untouched
+func TestSignatureInfoClone(t *testing.T) {
+	original, err := ParseSignatureInfo(newTestSignatureInfo(t, [32]byte{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := *original
+
+	clone := original.Clone()
+	if clone == original || *clone != *original {
+		t.Fatalf("Expected an equal copy at a different address")
+	}
+
+	clone.Signature[0] ^= 0xff
+	clone.PublicKey[31] ^= 0xff
+	if *original != snapshot {
+		t.Errorf("Mutating the clone changed the original")
+	}
+
+	var nilInfo *SignatureInfo
+	if nilInfo.Clone() != nil {
+		t.Errorf("Expected nil clone of nil SignatureInfo")
+	}
+}
+
+// TestSignatureInfoMatchesAddress tests matching and non-matching senders
+func TestSignatureInfoMatchesAddress(t *testing.T) {
+	kat := KnownAnswerTests[0]
+	info := NewSignatureInfoFromArrays(kat.Signature, kat.PublicKey)
+
+	if !info.MatchesAddress(kat.Address) {
+		t.Errorf("Expected key to match %s", kat.Address)
+	}
+	for _, other := range []ExecutionAddress{{}, KnownAnswerTests[1].Address} {
+		if info.MatchesAddress(other) {
+			t.Errorf("Expected key not to match %s", other)
+		}
+	}
+
+	var nilInfo *SignatureInfo
+	if nilInfo.MatchesAddress(ExecutionAddress{}) {
+		t.Errorf("Expected nil SignatureInfo to match nothing")
+	}
+}
+
+// TestVerifyKeyOnly tests that the returned key is a verified copy
+func TestVerifyKeyOnly(t *testing.T) {
+	payloadHash := [32]byte{0x33}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	publicKey, err := VerifyKeyOnly(signatureInfo, payloadHash)
+	if err != nil {
+		t.Fatalf("VerifyKeyOnly failed: %v", err)
+	}
+	if !bytes.Equal(publicKey, signatureInfo[64:]) {
+		t.Fatalf("Returned key does not match signature info")
+	}
+
+	signatureInfo[64] ^= 0xff
+	if publicKey[0] == signatureInfo[64] {
+		t.Errorf("Returned key aliases the input buffer")
+	}
+
+	if _, err := VerifyKeyOnly(newTestSignatureInfo(t, payloadHash), [32]byte{}); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if _, err := VerifyKeyOnly(signatureInfo[:95], payloadHash); err == nil {
+		t.Errorf("Expected length error")
+	}
+}
+
+// TestVerifySplit tests that VerifySplit matches Verify and rejects wrong lengths
+func TestVerifySplit(t *testing.T) {
+	payloadHash := [32]byte{0x44}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	signature, publicKey := signatureInfo[:64], signatureInfo[64:]
+
+	want, _ := Verify(signatureInfo, payloadHash)
+	if got, err := VerifySplit(signature, publicKey, payloadHash); err != nil || got != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+	if _, err := VerifySplit(signature, publicKey, [32]byte{}); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		signature []byte
+		publicKey []byte
+	}{
+		{"nil signature", nil, publicKey},
+		{"63-byte signature", signature[:63], publicKey},
+		{"65-byte signature", signatureInfo[:65], publicKey},
+		{"nil key", signature, nil},
+		{"31-byte key", signature, publicKey[:31]},
+		{"33-byte key", signature, signatureInfo[63:]},
+		{"swapped", publicKey, signature},
+	} {
+		if _, err := VerifySplit(tc.signature, tc.publicKey, payloadHash); err == nil {
+			t.Errorf("%s: expected length error", tc.name)
+		}
+	}
+
+	if _, err := VerifySplit(signature, publicKey[:31], payloadHash); !errors.Is(err, ErrPublicKeyLength) {
+		t.Errorf("Expected ErrPublicKeyLength, got %v", err)
+	}
+}
+
+// TestVerifyFromTuple tests correct and malformed tuples
+func TestVerifyFromTuple(t *testing.T) {
+	payloadHash := [32]byte{0x55}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	signature, publicKey := signatureInfo[:64], signatureInfo[64:]
+
+	want, _ := Verify(signatureInfo, payloadHash)
+	if got, err := VerifyFromTuple([][]byte{signature, publicKey}, payloadHash); err != nil || got != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+	if _, err := VerifyFromTuple([][]byte{signature, publicKey}, [32]byte{}); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		tuple [][]byte
+		want  string
+	}{
+		{"nil", nil, "expected 2 elements"},
+		{"one element", [][]byte{signatureInfo}, "got 1"},
+		{"three elements", [][]byte{signature, publicKey, nil}, "got 3"},
+		{"swapped", [][]byte{publicKey, signature}, "element 0 (signature) must be 64 bytes, got 32"},
+		{"short key", [][]byte{signature, publicKey[:31]}, "element 1 (publicKey) must be 32 bytes, got 31"},
+		{"empty signature", [][]byte{{}, publicKey}, "got 0"},
+	} {
+		_, err := VerifyFromTuple(tc.tuple, payloadHash)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.want, err)
+		}
+	}
+}
+
+// TestVerifyAllocBudget tests that verification stays within its allocation budget
+//
+// Current budgets: Verify makes 1 allocation per call (the keccak256 state
+// used for address derivation); Verifier.Verify reuses its buffers and makes
+// none. Public key decoding must keep its edwards25519.Point on the stack.
+func TestVerifyAllocBudget(t *testing.T) {
+	const (
+		verifyBudget   = 1
+		verifierBudget = 0
+	)
+
+	payloadHash := [32]byte{5}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	verifier := NewVerifier()
+
+	if allocs := testing.AllocsPerRun(100, func() { _, _ = Verify(signatureInfo, payloadHash) }); allocs > verifyBudget {
+		t.Errorf("Verify made %v allocations per call, budget is %d", allocs, verifyBudget)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { _, _ = verifier.Verify(signatureInfo, payloadHash) }); allocs > verifierBudget {
+		t.Errorf("Verifier.Verify made %v allocations per call, budget is %d", allocs, verifierBudget)
+	}
+}
+
+// BenchmarkVerifyKeyOnly benchmarks verification without address derivation
+func BenchmarkVerifyKeyOnly(b *testing.B) {
+	payloadHash := [32]byte{}
+	signatureInfo := newTestSignatureInfo(b, payloadHash)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = VerifyKeyOnly(signatureInfo, payloadHash)
+	}
+}
+
+// BenchmarkVerifyFullDerivation benchmarks Verify for comparison with BenchmarkVerifyKeyOnly
+func BenchmarkVerifyFullDerivation(b *testing.B) {
+	payloadHash := [32]byte{}
+	signatureInfo := newTestSignatureInfo(b, payloadHash)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Verify(signatureInfo, payloadHash)
+	}
+}
@@ -0,0 +1,83 @@
+package eip7980
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// ParseSignatureInfoListLenient parses a buffer of concatenated signature_info
+// records, collecting every complete record and skipping the rest
+//
+// It assumes fixed 96-byte framing: the buffer is walked in MAX_SIZE strides
+// from offset 0, so a record that was truncated or padded in the middle of the
+// buffer shifts every following record and cannot be resynchronised. Only a
+// trailing partial record is detected; it is dropped and counted as skipped.
+// This is intended for forensic recovery from damaged logs, not for parsing
+// untrusted input on the consensus path.
+//
+// Returns:
+//   - valid: records parsed from each complete 96-byte stride
+//   - skipped: number of records that could not be parsed
+func ParseSignatureInfoListLenient(data []byte) (valid []*SignatureInfo, skipped int) {
+	for offset := 0; offset < len(data); offset += MAX_SIZE {
+		end := offset + MAX_SIZE
+		if end > len(data) {
+			skipped++
+			break
+		}
+
+		sigInfo, err := ParseSignatureInfo(data[offset:end])
+		if err != nil {
+			skipped++
+			continue
+		}
+		valid = append(valid, sigInfo)
+	}
+
+	return valid, skipped
+}
+
+// batchCountSize is the length of the big-endian record count prefix written by PackBatch
+const batchCountSize = 4
+
+// PackBatch serializes infos as a 4-byte big-endian count followed by the 96-byte records in order
+//
+// Unlike SignatureSet.MarshalBinary, order is preserved and duplicates are
+// allowed. A nil entry is packed as 96 zero bytes.
+func PackBatch(infos []*SignatureInfo) []byte {
+	data := make([]byte, batchCountSize, batchCountSize+len(infos)*MAX_SIZE)
+	binary.BigEndian.PutUint32(data, uint32(len(infos)))
+	for _, info := range infos {
+		if info == nil {
+			info = &SignatureInfo{}
+		}
+		data = append(data, info.ToBytes()...)
+	}
+	return data
+}
+
+// UnpackBatch decodes a blob produced by PackBatch
+//
+// The body must hold exactly count records; the declared count is checked
+// against the input length before anything is allocated.
+func UnpackBatch(data []byte) ([]*SignatureInfo, error) {
+	if len(data) < batchCountSize {
+		return nil, fmt.Errorf("invalid batch length: expected at least %d, got %d", batchCountSize, len(data))
+	}
+
+	count := binary.BigEndian.Uint32(data)
+	body := data[batchCountSize:]
+	if uint64(len(body)) != uint64(count)*MAX_SIZE {
+		return nil, fmt.Errorf("invalid batch length: %d records require %d bytes, got %d", count, uint64(count)*MAX_SIZE, len(body))
+	}
+
+	infos := make([]*SignatureInfo, count)
+	for i := range infos {
+		info, err := ParseSignatureInfo(body[i*MAX_SIZE : (i+1)*MAX_SIZE])
+		if err != nil {
+			return nil, err
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
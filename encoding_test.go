@@ -0,0 +1,112 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+)
+
+// newTestSignatureInfo signs payloadHash with a fresh key and returns the packed signature_info
+func newTestSignatureInfo(t testing.TB, payloadHash [32]byte) []byte {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signatureInfo := make([]byte, MAX_SIZE)
+	copy(signatureInfo[:64], ed25519.Sign(privateKey, payloadHash[:]))
+	copy(signatureInfo[64:], publicKey)
+	return signatureInfo
+}
+
+// TestParseSignatureInfoListLenient tests recovery of complete records from a damaged buffer
+func TestParseSignatureInfoListLenient(t *testing.T) {
+	payloadHash := [32]byte{}
+	first := newTestSignatureInfo(t, payloadHash)
+	second := newTestSignatureInfo(t, payloadHash)
+
+	data := append(append([]byte{}, first...), second...)
+
+	valid, skipped := ParseSignatureInfoListLenient(data)
+	if len(valid) != 2 || skipped != 0 {
+		t.Fatalf("got %d valid, %d skipped, want 2 valid, 0 skipped", len(valid), skipped)
+	}
+	if !bytes.Equal(valid[0].ToBytes(), first) || !bytes.Equal(valid[1].ToBytes(), second) {
+		t.Errorf("Parsed records do not match input")
+	}
+
+	// Trailing garbage from a truncated third record
+	data = append(data, 0x01, 0x02, 0x03)
+
+	valid, skipped = ParseSignatureInfoListLenient(data)
+	if len(valid) != 2 || skipped != 1 {
+		t.Errorf("got %d valid, %d skipped, want 2 valid, 1 skipped", len(valid), skipped)
+	}
+}
+
+// TestParseSignatureInfoListLenientEmpty tests that short buffers yield no records
+func TestParseSignatureInfoListLenientEmpty(t *testing.T) {
+	valid, skipped := ParseSignatureInfoListLenient(nil)
+	if len(valid) != 0 || skipped != 0 {
+		t.Errorf("nil input: got %d valid, %d skipped", len(valid), skipped)
+	}
+
+	valid, skipped = ParseSignatureInfoListLenient(make([]byte, 50))
+	if len(valid) != 0 || skipped != 1 {
+		t.Errorf("short input: got %d valid, %d skipped, want 0 valid, 1 skipped", len(valid), skipped)
+	}
+}
+
+// TestPackBatchRoundTrip tests lossless packing, including order and duplicates
+func TestPackBatchRoundTrip(t *testing.T) {
+	var infos []*SignatureInfo
+	for i := 0; i < 3; i++ {
+		info, err := ParseSignatureInfo(newTestSignatureInfo(t, [32]byte{byte(i)}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos = append(infos, info)
+	}
+	infos = append(infos, infos[0])
+
+	data := PackBatch(infos)
+	if len(data) != 4+len(infos)*MAX_SIZE {
+		t.Fatalf("Packed length = %d, want %d", len(data), 4+len(infos)*MAX_SIZE)
+	}
+
+	unpacked, err := UnpackBatch(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unpacked) != len(infos) {
+		t.Fatalf("Got %d records, want %d", len(unpacked), len(infos))
+	}
+	for i := range infos {
+		if *unpacked[i] != *infos[i] {
+			t.Errorf("Record %d differs after round trip", i)
+		}
+	}
+
+	empty, err := UnpackBatch(PackBatch(nil))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Empty batch: got %d records, %v", len(empty), err)
+	}
+}
+
+// TestUnpackBatchRejects tests validation of the count header against the body
+func TestUnpackBatchRejects(t *testing.T) {
+	data := PackBatch([]*SignatureInfo{{}, {}})
+
+	for name, input := range map[string][]byte{
+		"no header":      data[:3],
+		"truncated body": data[:len(data)-1],
+		"trailing byte":  append(append([]byte(nil), data...), 0),
+		"huge count":     {0xff, 0xff, 0xff, 0xff},
+	} {
+		if _, err := UnpackBatch(input); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
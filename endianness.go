@@ -0,0 +1,57 @@
+package eip7980
+
+import (
+	"fmt"
+	"slices"
+)
+
+// endiannessRepairs are the byte-order mistakes DiagnoseEndianness tries, in order
+var endiannessRepairs = []struct {
+	description string
+	reverseKey  bool
+	reverseRS   bool
+	swapRS      bool
+}{
+	{"public key byte-reversed", true, false, false},
+	{"R and S each byte-reversed", false, true, false},
+	{"public key, R and S each byte-reversed", true, true, false},
+	{"R and S swapped", false, false, true},
+	{"public key byte-reversed, R and S swapped", true, false, true},
+	{"the whole signature byte-reversed", false, true, true},
+}
+
+// DiagnoseEndianness explains whether a failing signature_info verifies once byte order is repaired
+//
+// It is a debugging aid for interop with implementations that reverse the
+// public key or the R and S halves of the signature, or emit S before R. It never changes what
+// Verify accepts and must not be used to decide validity: call it
+// explicitly, after Verify has failed, and show the result to a human.
+func DiagnoseEndianness(signatureInfo []byte, payloadHash [32]byte) string {
+	if len(signatureInfo) != MAX_SIZE {
+		return fmt.Sprintf("signature_info is %d bytes, expected %d; byte order cannot be diagnosed", len(signatureInfo), MAX_SIZE)
+	}
+	if _, err := Verify(signatureInfo, payloadHash); err == nil {
+		return "signature_info verifies as-is; byte order is correct"
+	}
+
+	for _, repair := range endiannessRepairs {
+		candidate := slices.Clone(signatureInfo)
+		if repair.reverseRS {
+			slices.Reverse(candidate[:32])
+			slices.Reverse(candidate[32:64])
+		}
+		if repair.swapRS {
+			r := [32]byte(candidate[:32])
+			copy(candidate[:32], candidate[32:64])
+			copy(candidate[32:64], r[:])
+		}
+		if repair.reverseKey {
+			slices.Reverse(candidate[64:])
+		}
+
+		if address, err := Verify(candidate, payloadHash); err == nil {
+			return fmt.Sprintf("signature_info verifies with %s (signer %s); the encoder likely uses the wrong byte order", repair.description, address)
+		}
+	}
+	return "signature_info does not verify under any byte-order repair; the failure is not an endianness mistake"
+}
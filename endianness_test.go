@@ -0,0 +1,57 @@
+package eip7980
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestDiagnoseEndianness tests that each byte-order mistake is identified
+func TestDiagnoseEndianness(t *testing.T) {
+	payloadHash := [32]byte{9}
+	valid := newTestSignatureInfo(t, payloadHash)
+	address, _ := Verify(valid, payloadHash)
+
+	reversedKey := slices.Clone(valid)
+	slices.Reverse(reversedKey[64:])
+
+	reversedRS := slices.Clone(valid)
+	slices.Reverse(reversedRS[:32])
+	slices.Reverse(reversedRS[32:64])
+
+	reversedAll := slices.Clone(reversedRS)
+	slices.Reverse(reversedAll[64:])
+
+	swappedRS := slices.Concat(valid[32:64], valid[:32], valid[64:])
+
+	swappedRSReversedKey := slices.Clone(swappedRS)
+	slices.Reverse(swappedRSReversedKey[64:])
+
+	reversedSignature := slices.Clone(valid)
+	slices.Reverse(reversedSignature[:64])
+
+	for _, tc := range []struct {
+		name          string
+		signatureInfo []byte
+		hash          [32]byte
+		want          string
+	}{
+		{"valid", valid, payloadHash, "verifies as-is"},
+		{"reversed key", reversedKey, payloadHash, "with public key byte-reversed (signer " + address.String()},
+		{"reversed R and S", reversedRS, payloadHash, "with R and S each byte-reversed"},
+		{"everything reversed", reversedAll, payloadHash, "with public key, R and S each byte-reversed"},
+		{"swapped R and S", swappedRS, payloadHash, "with R and S swapped (signer " + address.String()},
+		{"swapped R and S, reversed key", swappedRSReversedKey, payloadHash, "with public key byte-reversed, R and S swapped"},
+		{"reversed signature", reversedSignature, payloadHash, "with the whole signature byte-reversed"},
+		{"wrong hash", valid, [32]byte{}, "not an endianness mistake"},
+		{"short", valid[:95], payloadHash, "95 bytes"},
+	} {
+		if got := DiagnoseEndianness(tc.signatureInfo, tc.hash); !strings.Contains(got, tc.want) {
+			t.Errorf("%s: expected diagnosis containing %q, got %q", tc.name, tc.want, got)
+		}
+	}
+
+	if _, err := Verify(reversedKey, payloadHash); err == nil {
+		t.Errorf("Diagnosis must not make Verify accept a reversed key")
+	}
+}
@@ -0,0 +1,171 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// EXPIRY_DOMAIN_TAG prefixes every expiring-signature digest so it cannot collide with other payloads
+const EXPIRY_DOMAIN_TAG = "EIP-7980 expiring signature v1"
+
+// expiringSignatureSize is the binary envelope size: notAfter timestamp + signature_info
+const expiringSignatureSize = TIMESTAMP_SIZE + MAX_SIZE
+
+// Errors returned by VerifyExpiring and the ExpiringSignature decoders; expiry
+// itself is reported with ErrExpired
+var (
+	ErrExpiryTooFar  = errors.New("signature expiry is too far in the future")
+	errNilExpiring   = errors.New("nil expiring signature")
+	errExpiringBytes = errors.New("invalid expiring signature encoding")
+)
+
+// DEFAULT_EXPIRY_HORIZON is the horizon VerifyExpiring uses when given a non-positive maxHorizon
+const DEFAULT_EXPIRY_HORIZON = 24 * time.Hour
+
+// ExpiringSignature is a signature that is only valid until NotAfter
+//
+// NotAfter has one-second resolution. The signed payload hash is
+// ExpiryPayloadHash(NotAfter, payload).
+type ExpiringSignature struct {
+	NotAfter      time.Time     // Last instant, inclusive, at which the signature is valid
+	SignatureInfo SignatureInfo // Signature over ExpiryPayloadHash and the signer's key
+}
+
+// ExpiryPayloadHash returns keccak256(EXPIRY_DOMAIN_TAG || notAfter || payload)
+//
+// notAfter is encoded as big-endian uint64 Unix seconds.
+func ExpiryPayloadHash(notAfter time.Time, payload []byte) [32]byte {
+	var seconds [TIMESTAMP_SIZE]byte
+	binary.BigEndian.PutUint64(seconds[:], uint64(notAfter.Unix()))
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(EXPIRY_DOMAIN_TAG))
+	hash.Write(seconds[:])
+	hash.Write(payload)
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// SignWithExpiry signs payload so that the signature expires after notAfter
+//
+// notAfter is truncated to the second and stored in UTC.
+func SignWithExpiry(privateKey ed25519.PrivateKey, payload []byte, notAfter time.Time) (*ExpiringSignature, error) {
+	es := &ExpiringSignature{NotAfter: time.Unix(notAfter.Unix(), 0).UTC()}
+	signatureInfo, err := Sign(privateKey, ExpiryPayloadHash(es.NotAfter, payload))
+	if err != nil {
+		return nil, err
+	}
+
+	copy(es.SignatureInfo.Signature[:], signatureInfo[:64])
+	copy(es.SignatureInfo.PublicKey[:], signatureInfo[64:])
+	return es, nil
+}
+
+// VerifyExpiring verifies es over payload at time now
+//
+// It returns ErrExpired once now is past NotAfter and ErrExpiryTooFar when
+// NotAfter is more than maxHorizon after now, which limits the damage of a
+// signer with a badly skewed clock. A non-positive maxHorizon means
+// DEFAULT_EXPIRY_HORIZON. The time checks run before the signature check.
+func VerifyExpiring(es *ExpiringSignature, payload []byte, maxHorizon time.Duration, now time.Time) (ExecutionAddress, error) {
+	if es == nil {
+		return ExecutionAddress{}, errNilExpiring
+	}
+
+	notAfter := es.NotAfter.Unix()
+	if now.Unix() > notAfter {
+		return ExecutionAddress{}, fmt.Errorf("%w: not after %s", ErrExpired, es.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if maxHorizon <= 0 {
+		maxHorizon = DEFAULT_EXPIRY_HORIZON
+	}
+	// Compare in seconds: converting the gap to a Duration overflows for
+	// NotAfter values a few centuries out. notAfter >= now here, so the
+	// unsigned difference is exact even when the signed one would wrap.
+	if uint64(notAfter-now.Unix()) > uint64(maxHorizon/time.Second) {
+		return ExecutionAddress{}, fmt.Errorf("%w: not after %s exceeds %s", ErrExpiryTooFar, es.NotAfter.UTC().Format(time.RFC3339), maxHorizon)
+	}
+
+	return Verify(es.SignatureInfo.ToBytes(), ExpiryPayloadHash(es.NotAfter, payload))
+}
+
+// expiringSignatureJSON is the JSON form of ExpiringSignature
+type expiringSignatureJSON struct {
+	NotAfter      int64  `json:"notAfter"`
+	SignatureInfo string `json:"signatureInfo"`
+}
+
+// MarshalJSON encodes es as {"notAfter": unix seconds, "signatureInfo": "0x..."}
+func (es *ExpiringSignature) MarshalJSON() ([]byte, error) {
+	if es == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(expiringSignatureJSON{
+		NotAfter:      es.NotAfter.Unix(),
+		SignatureInfo: "0x" + hex.EncodeToString(es.SignatureInfo.ToBytes()),
+	})
+}
+
+// UnmarshalJSON decodes the form written by MarshalJSON
+func (es *ExpiringSignature) UnmarshalJSON(data []byte) error {
+	if es == nil {
+		return errNilExpiring
+	}
+
+	var decoded expiringSignatureJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("%w: %v", errExpiringBytes, err)
+	}
+	if !strings.HasPrefix(decoded.SignatureInfo, "0x") {
+		return fmt.Errorf("%w: signatureInfo missing 0x prefix", errExpiringBytes)
+	}
+	raw, err := hex.DecodeString(decoded.SignatureInfo[2:])
+	if err != nil {
+		return fmt.Errorf("%w: %v", errExpiringBytes, err)
+	}
+	info, err := ParseSignatureInfo(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errExpiringBytes, err)
+	}
+
+	es.NotAfter = time.Unix(decoded.NotAfter, 0).UTC()
+	es.SignatureInfo = *info
+	return nil
+}
+
+// MarshalBinary encodes es as big-endian uint64 Unix seconds followed by the 96-byte signature_info
+func (es *ExpiringSignature) MarshalBinary() ([]byte, error) {
+	if es == nil {
+		return nil, errNilExpiring
+	}
+
+	data := make([]byte, expiringSignatureSize)
+	binary.BigEndian.PutUint64(data[:TIMESTAMP_SIZE], uint64(es.NotAfter.Unix()))
+	copy(data[TIMESTAMP_SIZE:], es.SignatureInfo.ToBytes())
+	return data, nil
+}
+
+// UnmarshalBinary decodes the form written by MarshalBinary
+func (es *ExpiringSignature) UnmarshalBinary(data []byte) error {
+	if es == nil {
+		return errNilExpiring
+	}
+	if len(data) != expiringSignatureSize {
+		return fmt.Errorf("%w: expected %d bytes, got %d", errExpiringBytes, expiringSignatureSize, len(data))
+	}
+
+	es.NotAfter = time.Unix(int64(binary.BigEndian.Uint64(data[:TIMESTAMP_SIZE])), 0).UTC()
+	copy(es.SignatureInfo.Signature[:], data[TIMESTAMP_SIZE:TIMESTAMP_SIZE+64])
+	copy(es.SignatureInfo.PublicKey[:], data[TIMESTAMP_SIZE+64:])
+	return nil
+}
@@ -0,0 +1,125 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestVerifyExpiringSkewBoundaries tests the expiry and horizon boundaries to the second
+func TestVerifyExpiringSkewBoundaries(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := DeriveAddress(publicKey)
+
+	now := time.Unix(1_700_000_000, 0)
+	payload := []byte("login nonce 42")
+
+	for _, tc := range []struct {
+		name     string
+		notAfter time.Time
+		now      time.Time
+		err      error
+	}{
+		{"well before expiry", now.Add(time.Hour), now, nil},
+		{"at expiry", now, now, nil},
+		{"sub-second past expiry", now, now.Add(999 * time.Millisecond), nil},
+		{"one second past expiry", now, now.Add(time.Second), ErrExpired},
+		{"at horizon", now.Add(DEFAULT_EXPIRY_HORIZON), now, nil},
+		{"one second past horizon", now.Add(DEFAULT_EXPIRY_HORIZON + time.Second), now, ErrExpiryTooFar},
+		{"295 years out", now.AddDate(295, 0, 0), now, ErrExpiryTooFar},
+		{"max int64 seconds", time.Unix(math.MaxInt64, 0), now, ErrExpiryTooFar},
+		{"max int64 seconds before epoch now", time.Unix(math.MaxInt64, 0), time.Unix(-1_000_000, 0), ErrExpiryTooFar},
+	} {
+		es, err := SignWithExpiry(privateKey, payload, tc.notAfter)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := VerifyExpiring(es, payload, 0, tc.now)
+		if tc.err == nil {
+			if err != nil || got != want {
+				t.Errorf("%s: expected %s, got %s, %v", tc.name, want, got, err)
+			}
+			continue
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+
+	// An explicit horizon replaces the default
+	es, _ := SignWithExpiry(privateKey, payload, now.Add(2*time.Hour))
+	if _, err := VerifyExpiring(es, payload, time.Hour, now); !errors.Is(err, ErrExpiryTooFar) {
+		t.Errorf("Expected ErrExpiryTooFar with a 1h horizon, got %v", err)
+	}
+	if _, err := VerifyExpiring(es, payload, 3*time.Hour, now); err != nil {
+		t.Errorf("Expected success with a 3h horizon, got %v", err)
+	}
+
+	es, _ = SignWithExpiry(privateKey, payload, now.Add(time.Minute))
+	if _, err := VerifyExpiring(es, []byte("other"), 0, now); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for a different payload, got %v", err)
+	}
+
+	// Extending NotAfter changes the signed digest
+	es.NotAfter = es.NotAfter.Add(time.Minute)
+	if _, err := VerifyExpiring(es, payload, 0, now); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for an extended NotAfter, got %v", err)
+	}
+}
+
+// TestExpiringSignatureEncoding tests JSON and binary round trips and malformed input
+func TestExpiringSignatureEncoding(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	es, err := SignWithExpiry(privateKey, []byte("payload"), time.Unix(1_700_000_000, 500))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON ExpiringSignature
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !fromJSON.NotAfter.Equal(es.NotAfter) || fromJSON.SignatureInfo != es.SignatureInfo {
+		t.Errorf("JSON round trip changed envelope: %s", data)
+	}
+
+	binaryData, err := es.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromBinary ExpiringSignature
+	if err := fromBinary.UnmarshalBinary(binaryData); err != nil {
+		t.Fatal(err)
+	}
+	again, _ := fromBinary.MarshalBinary()
+	if !bytes.Equal(again, binaryData) || !fromBinary.NotAfter.Equal(es.NotAfter) {
+		t.Errorf("Binary round trip changed envelope")
+	}
+
+	if err := fromBinary.UnmarshalBinary(binaryData[1:]); err == nil {
+		t.Errorf("Expected error for short binary envelope")
+	}
+	for _, bad := range []string{`{"notAfter": 1, "signatureInfo": "00"}`, `{"notAfter": 1, "signatureInfo": "0x00"}`, `[]`} {
+		if err := json.Unmarshal([]byte(bad), &fromJSON); err == nil {
+			t.Errorf("Expected error for %s", bad)
+		}
+	}
+	if _, err := SignWithExpiry(privateKey[:10], nil, time.Now()); err == nil {
+		t.Errorf("Expected error for short private key")
+	}
+}
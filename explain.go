@@ -0,0 +1,118 @@
+package eip7980
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// failureReportMaxBytes caps how much of a malformed signature_info FailureReport dumps
+const failureReportMaxBytes = 2 * MAX_SIZE
+
+// Check is the outcome of one verification stage or component decoding reported by Explain
+type Check struct {
+	Name string // "length", "public key" or "signature"; "R", "S" or "public key" for components
+	Err  error  // nil if the stage passed or the component decoded
+}
+
+// Explanation is the stage-by-stage outcome of verifying one signature_info
+type Explanation struct {
+	Checks  []Check          // Stages in Verify order, up to and including the first failure
+	Address ExecutionAddress // Derived address; zero unless every check passed
+
+	// Components holds the DecodedR, DecodedS and DecodedPublicKey results,
+	// in that order, or nothing if signature_info has the wrong length. They
+	// do not affect Err: a signature stage failure is explained by a
+	// component that does not decode, or else by the verification equation.
+	Components []Check
+}
+
+// Err returns the first failing check's error, or nil if verification succeeded
+func (e Explanation) Err() error {
+	for _, check := range e.Checks {
+		if check.Err != nil {
+			return check.Err
+		}
+	}
+	return nil
+}
+
+// Explain performs the same checks as Verify and records the outcome of each
+//
+// Stages after the first failure are not run and do not appear in Checks,
+// so Err always agrees with the error Verify would return. Components are
+// decoded whenever the length is right, whatever the stages found.
+func Explain(signatureInfo []byte, payloadHash [32]byte) Explanation {
+	var explanation Explanation
+	opts := verifyOptions{
+		backend: currentBackend(),
+		observe: func(stage string, err error) {
+			explanation.Checks = append(explanation.Checks, Check{Name: stage, Err: err})
+		},
+	}
+
+	publicKey, err := verifySignatureInfo(signatureInfo, payloadHash, opts)
+	if err == nil {
+		explanation.Address = deriveAddress(publicKey)
+	}
+
+	if info, err := ParseSignatureInfo(signatureInfo); err == nil {
+		_, errR := info.DecodedR()
+		_, errS := info.DecodedS()
+		_, errKey := info.DecodedPublicKey()
+		explanation.Components = []Check{{"R", errR}, {"S", errS}, {"public key", errKey}}
+	}
+	return explanation
+}
+
+// FailureReport returns a multi-line, paste-ready summary of a verification for bug reports
+//
+// It lists the input lengths, the hex of each field with any error decoding
+// it, every check Explain ran with its result, and the derived address if
+// verification succeeded.
+// Everything in the report is public data; oversized inputs are truncated.
+func FailureReport(signatureInfo []byte, payloadHash [32]byte) string {
+	explanation := Explain(signatureInfo, payloadHash)
+
+	var b strings.Builder
+	b.WriteString("EIP-7980 verification report\n")
+	fmt.Fprintf(&b, "signature_info length: %d (expected %d)\n", len(signatureInfo), MAX_SIZE)
+	fmt.Fprintf(&b, "payload hash:          %s\n", Hash32(payloadHash))
+	if payloadHash == ([32]byte{}) {
+		b.WriteString("warning:               payload hash is all zeros; the builder may not have computed it\n")
+	}
+
+	if info, err := ParseSignatureInfo(signatureInfo); err == nil {
+		r, s := info.R(), info.S()
+		for i, value := range [][32]byte{r, s, info.PublicKey} {
+			component := explanation.Components[i]
+			fmt.Fprintf(&b, "%-22s 0x%x", component.Name+":", value)
+			if component.Err != nil {
+				fmt.Fprintf(&b, " (does not decode: %v)", component.Err)
+			}
+			b.WriteString("\n")
+		}
+	} else {
+		dump := signatureInfo[:min(len(signatureInfo), failureReportMaxBytes)]
+		fmt.Fprintf(&b, "signature_info:        0x%s", hex.EncodeToString(dump))
+		if len(dump) < len(signatureInfo) {
+			fmt.Fprintf(&b, " (truncated, %d bytes omitted)", len(signatureInfo)-len(dump))
+		}
+		b.WriteString("\n")
+	}
+
+	for _, check := range explanation.Checks {
+		if check.Err != nil {
+			fmt.Fprintf(&b, "check %-16s FAILED: %v\n", check.Name+":", check.Err)
+		} else {
+			fmt.Fprintf(&b, "check %-16s ok\n", check.Name+":")
+		}
+	}
+
+	if explanation.Err() != nil {
+		b.WriteString("derived address:       none\n")
+	} else {
+		fmt.Fprintf(&b, "derived address:       %s\n", explanation.Address.ChecksumString())
+	}
+	return b.String()
+}
@@ -0,0 +1,106 @@
+package eip7980
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestExplainAgreesWithVerify tests that Explain stops at the check Verify fails on
+func TestExplainAgreesWithVerify(t *testing.T) {
+	kat := KnownAnswerTests[0]
+	valid := kat.SignatureInfo()
+
+	badKey := append([]byte(nil), valid...)
+	copy(badKey[64:], invalidPublicKeyVectors()["y=2 not on curve"])
+
+	for _, tc := range []struct {
+		name          string
+		signatureInfo []byte
+		hash          [32]byte
+		failing       string
+	}{
+		{"valid", valid, kat.PayloadHash, ""},
+		{"short", valid[:64], kat.PayloadHash, "length"},
+		{"bad key", badKey, kat.PayloadHash, "public key"},
+		{"wrong hash", valid, [32]byte{1}, "signature"},
+	} {
+		explanation := Explain(tc.signatureInfo, tc.hash)
+		address, err := Verify(tc.signatureInfo, tc.hash)
+
+		if gotErr := explanation.Err(); (gotErr == nil) != (err == nil) || (err != nil && gotErr.Error() != err.Error()) {
+			t.Errorf("%s: Explain error %v, Verify error %v", tc.name, gotErr, err)
+		}
+		if explanation.Address != address {
+			t.Errorf("%s: Explain address %s, Verify address %s", tc.name, explanation.Address, address)
+		}
+
+		last := explanation.Checks[len(explanation.Checks)-1]
+		if tc.failing != "" && (last.Name != tc.failing || last.Err == nil) {
+			t.Errorf("%s: expected last check %q to fail, got %q: %v", tc.name, tc.failing, last.Name, last.Err)
+		}
+	}
+}
+
+// TestFailureReport tests that the report names the failing check and the derived address
+func TestFailureReport(t *testing.T) {
+	kat := KnownAnswerTests[0]
+	valid := kat.SignatureInfo()
+
+	report := FailureReport(valid, [32]byte{1})
+	for _, want := range []string{
+		"signature_info length: 96 (expected 96)",
+		"check length:",
+		"check signature:       FAILED: " + ErrInvalidSignature.Error(),
+		"derived address:       none",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+
+	if report := FailureReport(valid, kat.PayloadHash); !strings.Contains(report, kat.Address.ChecksumString()) {
+		t.Errorf("Expected derived address in report, got:\n%s", report)
+	}
+
+	long := make([]byte, 1000)
+	report = FailureReport(long, kat.PayloadHash)
+	if !strings.Contains(report, "check length:") || !strings.Contains(report, "808 bytes omitted") || strings.Contains(report, "check signature") {
+		t.Errorf("Unexpected report for oversized input:\n%s", report)
+	}
+
+	explanation := Explain(nil, [32]byte{})
+	if !errors.Is(explanation.Err(), explanation.Checks[0].Err) {
+		t.Errorf("Err must return the failing check's error")
+	}
+}
+
+// TestExplainComponents tests that component decoding explains a failing signature stage
+func TestExplainComponents(t *testing.T) {
+	var nonCanonicalS KAT
+	for _, kat := range KnownAnswerTests {
+		if kat.Name == "non-canonical S (S + L)" {
+			nonCanonicalS = kat
+		}
+	}
+
+	explanation := Explain(nonCanonicalS.SignatureInfo(), nonCanonicalS.PayloadHash)
+	if len(explanation.Components) != 3 {
+		t.Fatalf("Expected 3 components, got %+v", explanation.Components)
+	}
+	if r, s, key := explanation.Components[0], explanation.Components[1], explanation.Components[2]; r.Err != nil || !errors.Is(s.Err, ErrSignatureSNonCanonical) || key.Err != nil {
+		t.Errorf("Expected only S to fail decoding, got %+v", explanation.Components)
+	}
+	if !errors.Is(explanation.Err(), ErrInvalidSignature) {
+		t.Errorf("Expected the signature stage to fail, got %v", explanation.Err())
+	}
+
+	report := FailureReport(nonCanonicalS.SignatureInfo(), nonCanonicalS.PayloadHash)
+	if !strings.Contains(report, "(does not decode: "+ErrSignatureSNonCanonical.Error()+")") || strings.Count(report, "does not decode") != 1 {
+		t.Errorf("Expected the report to flag S only, got:\n%s", report)
+	}
+
+	if explanation := Explain(make([]byte, 95), [32]byte{}); explanation.Components != nil {
+		t.Errorf("Expected no components for a short input, got %+v", explanation.Components)
+	}
+}
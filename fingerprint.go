@@ -0,0 +1,47 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// FINGERPRINT_SIZE is the number of keccak256 bytes kept in a public key fingerprint
+const FINGERPRINT_SIZE = 8
+
+// PublicKeyFingerprint returns a short, stable identifier for an Ed25519 public key
+//
+// The fingerprint is the hex encoding of the first 8 bytes of
+// keccak256(publicKey). The address uses the last 20 bytes of the same hash,
+// so the two do not overlap; the fingerprint is meant for log correlation
+// without printing the full key.
+func PublicKeyFingerprint(publicKey []byte) (string, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return "", fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidPublicKey, ed25519.PublicKeySize, len(publicKey))
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(publicKey)
+	fullHash := hash.Sum(nil)
+
+	return hex.EncodeToString(fullHash[:FINGERPRINT_SIZE]), nil
+}
+
+// SameSigner reports whether two signature_infos carry the same public key
+//
+// It compares the key bytes directly, without verifying either signature or
+// deriving addresses, so it is only suitable for grouping. Both inputs must
+// be MAX_SIZE bytes.
+func SameSigner(infoA, infoB []byte) (bool, error) {
+	if len(infoA) != MAX_SIZE {
+		return false, fmt.Errorf("invalid signature info A length: expected %d, got %d", MAX_SIZE, len(infoA))
+	}
+	if len(infoB) != MAX_SIZE {
+		return false, fmt.Errorf("invalid signature info B length: expected %d, got %d", MAX_SIZE, len(infoB))
+	}
+
+	return bytes.Equal(infoA[64:], infoB[64:]), nil
+}
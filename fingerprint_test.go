@@ -0,0 +1,71 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+// TestPublicKeyFingerprint tests fingerprint determinism and format
+func TestPublicKeyFingerprint(t *testing.T) {
+	publicKey := mustDecodeHex(t, solanaSecretKeyHex)[32:]
+
+	first, err := PublicKeyFingerprint(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := PublicKeyFingerprint(publicKey)
+
+	if first != second {
+		t.Errorf("Fingerprint is not deterministic: %s != %s", first, second)
+	}
+	if len(first) != 2*FINGERPRINT_SIZE {
+		t.Errorf("Fingerprint length = %d, want %d", len(first), 2*FINGERPRINT_SIZE)
+	}
+
+	other, _ := PublicKeyFingerprint(make([]byte, 32))
+	if other == first {
+		t.Errorf("Different keys produced the same fingerprint")
+	}
+
+	if _, err := PublicKeyFingerprint(publicKey[:31]); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("Expected ErrInvalidPublicKey, got %v", err)
+	}
+}
+
+// TestSameSigner tests same-key and different-key pairs and length validation
+func TestSameSigner(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(privateKey ed25519.PrivateKey, publicKey ed25519.PublicKey, message byte) []byte {
+		return append(ed25519.Sign(privateKey, []byte{message}), publicKey...)
+	}
+
+	a := sign(privateKey, publicKey, 1)
+	b := sign(privateKey, publicKey, 2)
+	other := newTestSignatureInfo(t, [32]byte{})
+
+	for _, tc := range []struct {
+		name string
+		a, b []byte
+		want bool
+	}{
+		{"same key, different messages", a, b, true},
+		{"identical", a, a, true},
+		{"different keys", a, other, false},
+	} {
+		got, err := SameSigner(tc.a, tc.b)
+		if err != nil || got != tc.want {
+			t.Errorf("%s: expected %v, got %v, %v", tc.name, tc.want, got, err)
+		}
+	}
+
+	if _, err := SameSigner(a[:95], b); err == nil {
+		t.Errorf("Expected length error for short A")
+	}
+	if _, err := SameSigner(a, append(b, 0)); err == nil {
+		t.Errorf("Expected length error for long B")
+	}
+}
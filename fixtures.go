@@ -0,0 +1,125 @@
+package eip7980
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// Lang is a fixture output language for ExportFixtures
+type Lang int
+
+// Fixture languages
+const (
+	LangJSON     Lang = iota // JSON array of KATs
+	LangSolidity             // Solidity library of constants
+)
+
+// String returns the language name accepted by ParseLang
+func (l Lang) String() string {
+	switch l {
+	case LangJSON:
+		return "json"
+	case LangSolidity:
+		return "solidity"
+	default:
+		return fmt.Sprintf("Lang(%d)", int(l))
+	}
+}
+
+// ParseLang parses a language name as printed by Lang.String
+func ParseLang(s string) (Lang, error) {
+	switch s {
+	case "json":
+		return LangJSON, nil
+	case "solidity":
+		return LangSolidity, nil
+	default:
+		return 0, fmt.Errorf("unknown fixture language %q: want json or solidity", s)
+	}
+}
+
+// fixturesSolidityTemplate renders LangSolidity output
+var fixturesSolidityTemplate = template.Must(template.New("fixtures.sol.tmpl").
+	Funcs(template.FuncMap{"hex": fixtureHex}).
+	ParseFS(templateFS, "templates/fixtures.sol.tmpl"))
+
+// fixtureHex hex-encodes a byte slice or a 32- or 64-byte array for templates
+//
+// Any other type is an error, which aborts template execution rather than
+// emitting a literal that is not hex.
+func fixtureHex(v any) (string, error) {
+	switch b := v.(type) {
+	case []byte:
+		return hex.EncodeToString(b), nil
+	case [32]byte:
+		return hex.EncodeToString(b[:]), nil
+	case [64]byte:
+		return hex.EncodeToString(b[:]), nil
+	default:
+		return "", fmt.Errorf("fixture hex: unsupported type %T", v)
+	}
+}
+
+// fixtureSeed seeds the Generator behind DeterministicVectors
+const fixtureSeed = 7980
+
+// DeterministicVectors returns count reproducible valid vectors
+//
+// Vector i is item i of a Generator seeded with fixtureSeed that signs with
+// its 64 keys in order, so the first 64 vectors have distinct signers and a
+// longer list extends a shorter one.
+func DeterministicVectors(count int) []KAT {
+	// Only the configuration could fail, and it is fixed
+	generator, _ := NewGenerator(GeneratorConfig{Seed: fixtureSeed, KeyReuse: KeyReuseSequential})
+
+	vectors := make([]KAT, 0, max(count, 0))
+	for i, item := range generator.Items(count) {
+		vector := KAT{
+			Name:        fmt.Sprintf("generated %d", i),
+			PayloadHash: item.PayloadHash,
+			Address:     item.Address,
+			Valid:       true,
+		}
+		copy(vector.Signature[:], item.SignatureInfo[:64])
+		copy(vector.PublicKey[:], item.SignatureInfo[64:])
+		vectors = append(vectors, vector)
+	}
+	return vectors
+}
+
+// ExportFixtures writes vectors as test fixtures in lang
+//
+// The JSON output is an array of KATs in their JSON encoding. Every vector
+// must behave as it declares: a valid vector must verify to its Address and
+// an invalid one must be rejected, so exported fixtures can never encode a
+// wrong expectation.
+func ExportFixtures(w io.Writer, lang Lang, vectors []KAT) error {
+	if w == nil {
+		return errNilWriter
+	}
+	for _, vector := range vectors {
+		address, err := Verify(vector.SignatureInfo(), vector.PayloadHash)
+		switch {
+		case vector.Valid && err != nil:
+			return fmt.Errorf("vector %q: %w", vector.Name, err)
+		case vector.Valid && address != vector.Address:
+			return fmt.Errorf("vector %q: %w: verifies to %s, expected %s", vector.Name, ErrAddressMismatch, address, vector.Address)
+		case !vector.Valid && err == nil:
+			return fmt.Errorf("vector %q: expected rejection, verifies to %s", vector.Name, address)
+		}
+	}
+
+	switch lang {
+	case LangJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(vectors)
+	case LangSolidity:
+		return fixturesSolidityTemplate.Execute(w, vectors)
+	default:
+		return fmt.Errorf("unknown fixture language %v", lang)
+	}
+}
@@ -0,0 +1,95 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestExportFixturesGolden tests the emitted Solidity and JSON against golden files
+func TestExportFixturesGolden(t *testing.T) {
+	vectors := append(slices.Clone(KnownAnswerTests), DeterministicVectors(3)...)
+
+	for _, tc := range []struct {
+		lang   Lang
+		golden string
+	}{
+		{LangSolidity, "fixtures.golden.sol"},
+		{LangJSON, "fixtures.golden.json"},
+	} {
+		var out bytes.Buffer
+		if err := ExportFixtures(&out, tc.lang, vectors); err != nil {
+			t.Fatalf("%v: %v", tc.lang, err)
+		}
+
+		golden := filepath.Join("testdata", tc.golden)
+		if *update {
+			if err := os.WriteFile(golden, out.Bytes(), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out.Bytes(), want) {
+			t.Errorf("%v output differs from %s:\ngot:\n%s\nwant:\n%s", tc.lang, golden, out.Bytes(), want)
+		}
+	}
+}
+
+// TestExportFixturesRejectsWrongVectors tests that fixtures cannot encode a wrong expectation
+func TestExportFixturesRejectsWrongVectors(t *testing.T) {
+	vectors := DeterministicVectors(2)
+	again := DeterministicVectors(2)
+	for i := range vectors {
+		if vectors[i] != again[i] {
+			t.Fatalf("Vector %d is not deterministic", i)
+		}
+	}
+
+	vectors[1].Address = vectors[0].Address
+	if err := ExportFixtures(&bytes.Buffer{}, LangJSON, vectors); !errors.Is(err, ErrAddressMismatch) {
+		t.Errorf("Expected ErrAddressMismatch, got %v", err)
+	}
+
+	vectors = DeterministicVectors(1)
+	vectors[0].PayloadHash[0] ^= 0x01
+	if err := ExportFixtures(&bytes.Buffer{}, LangSolidity, vectors); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	vectors = DeterministicVectors(1)
+	vectors[0].Valid = false
+	if err := ExportFixtures(&bytes.Buffer{}, LangJSON, vectors); err == nil || !strings.Contains(err.Error(), "expected rejection") {
+		t.Errorf("Expected a valid vector marked invalid to be rejected, got %v", err)
+	}
+
+	if err := ExportFixtures(&bytes.Buffer{}, Lang(9), nil); err == nil {
+		t.Errorf("Expected error for unknown language")
+	}
+	for _, name := range []string{"json", "solidity"} {
+		if lang, err := ParseLang(name); err != nil || lang.String() != name {
+			t.Errorf("ParseLang(%q) = %v, %v", name, lang, err)
+		}
+	}
+	if _, err := ParseLang("vyper"); err == nil {
+		t.Errorf("Expected error for unsupported language")
+	}
+}
+
+// TestFixtureHexRejectsUnsupported tests that the template helper errors instead of printing non-hex
+func TestFixtureHexRejectsUnsupported(t *testing.T) {
+	if got, err := fixtureHex([64]byte{0xab}); err != nil || got[:4] != "ab00" || len(got) != 128 {
+		t.Errorf("Expected 128 hex digits, got %q, %v", got, err)
+	}
+	for _, v := range []any{ExecutionAddress{}, "0xab", 7, nil} {
+		if got, err := fixtureHex(v); err == nil {
+			t.Errorf("%T: expected error, got %q", v, got)
+		}
+	}
+}
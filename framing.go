@@ -0,0 +1,40 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FRAMED_SIZE is the length of an EIP-7932 framed signature_info: ALG_TYPE followed by the 96-byte blob
+const FRAMED_SIZE = 1 + MAX_SIZE
+
+// ErrAlgType is returned by Unframe when the leading algorithm byte is not ALG_TYPE
+var ErrAlgType = errors.New("unsupported EIP-7932 algorithm type")
+
+// Frame prepends ALG_TYPE to a 96-byte signature_info, producing the framed
+// form carried by EIP-7932
+//
+// The result is a new slice; signatureInfo is not modified.
+func Frame(signatureInfo []byte) ([]byte, error) {
+	if len(signatureInfo) != MAX_SIZE {
+		return nil, fmt.Errorf("invalid signature info length: expected %d, got %d", MAX_SIZE, len(signatureInfo))
+	}
+
+	framed := make([]byte, FRAMED_SIZE)
+	framed[0] = ALG_TYPE
+	copy(framed[1:], signatureInfo)
+	return framed, nil
+}
+
+// Unframe checks the algorithm byte of a framed signature_info and returns
+// a copy of the 96-byte blob
+func Unframe(framed []byte) ([]byte, error) {
+	if len(framed) != FRAMED_SIZE {
+		return nil, fmt.Errorf("invalid framed signature info length: expected %d, got %d", FRAMED_SIZE, len(framed))
+	}
+	if framed[0] != ALG_TYPE {
+		return nil, fmt.Errorf("%w: 0x%02x, expected 0x%02x", ErrAlgType, framed[0], ALG_TYPE)
+	}
+
+	return append([]byte(nil), framed[1:]...), nil
+}
@@ -0,0 +1,54 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestFrameRoundTrip tests that Unframe(Frame(x)) returns x and the framed blob still verifies
+func TestFrameRoundTrip(t *testing.T) {
+	payloadHash := [32]byte{0x99}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	framed, err := Frame(signatureInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(framed) != FRAMED_SIZE || framed[0] != ALG_TYPE || !bytes.Equal(framed[1:], signatureInfo) {
+		t.Fatalf("Unexpected framing %x", framed)
+	}
+
+	unframed, err := Unframe(framed)
+	if err != nil || !bytes.Equal(unframed, signatureInfo) {
+		t.Fatalf("Round trip failed: %x, %v", unframed, err)
+	}
+	framed[1] ^= 0xff
+	if unframed[0] == framed[1] {
+		t.Errorf("Unframed blob aliases the framed input")
+	}
+	if _, err := Verify(unframed, payloadHash); err != nil {
+		t.Errorf("Unframed blob failed to verify: %v", err)
+	}
+}
+
+// TestFrameRejects tests wrong lengths and algorithm bytes
+func TestFrameRejects(t *testing.T) {
+	signatureInfo := make([]byte, MAX_SIZE)
+	for _, length := range []int{0, MAX_SIZE - 1, FRAMED_SIZE} {
+		if _, err := Frame(make([]byte, length)); err == nil {
+			t.Errorf("Frame: expected error for %d bytes", length)
+		}
+	}
+	for _, length := range []int{0, MAX_SIZE, FRAMED_SIZE + 1} {
+		if _, err := Unframe(make([]byte, length)); err == nil {
+			t.Errorf("Unframe: expected error for %d bytes", length)
+		}
+	}
+
+	framed, _ := Frame(signatureInfo)
+	framed[0] = 0x01
+	if _, err := Unframe(framed); !errors.Is(err, ErrAlgType) {
+		t.Errorf("Expected ErrAlgType, got %v", err)
+	}
+}
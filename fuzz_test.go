@@ -0,0 +1,126 @@
+package eip7980
+
+import (
+	"bytes"
+	"encoding/binary"
+	"runtime"
+	"testing"
+)
+
+// fuzzFixtures returns the shared seed corpus: deterministic signature infos
+// encoded both as single records and as a canonical SignatureSet
+func fuzzFixtures() (records [][]byte, sets [][]byte) {
+	var infos []*SignatureInfo
+	for i := 0; i < 3; i++ {
+		info := &SignatureInfo{}
+		for j := range info.Signature {
+			info.Signature[j] = byte(i*31 + j)
+		}
+		for j := range info.PublicKey {
+			info.PublicKey[j] = byte(i*17 + j)
+		}
+		infos = append(infos, info)
+		records = append(records, info.ToBytes())
+	}
+
+	set, _ := NewSignatureSet(infos...)
+	full, _ := set.MarshalBinary()
+	empty, _ := (&SignatureSet{}).MarshalBinary()
+
+	// A header declaring 2^32-1 entries with no body
+	huge := make([]byte, setCountSize)
+	binary.BigEndian.PutUint32(huge, ^uint32(0))
+
+	return records, [][]byte{full, empty, huge}
+}
+
+// FuzzSignatureInfo checks that any accepted record re-encodes to the same bytes
+func FuzzSignatureInfo(f *testing.F) {
+	records, sets := fuzzFixtures()
+	for _, seed := range append(records, sets...) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		info, err := ParseSignatureInfo(data)
+		if err != nil {
+			return
+		}
+		if !bytes.Equal(info.ToBytes(), data) {
+			t.Fatalf("Re-encoding differs from accepted input")
+		}
+	})
+}
+
+// FuzzSignatureSetBinary checks that decoding never panics, never allocates
+// for entries the input does not contain, and that any accepted input is canonical
+func FuzzSignatureSetBinary(f *testing.F) {
+	records, sets := fuzzFixtures()
+	for _, seed := range append(sets, records...) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+
+		var set SignatureSet
+		err := set.UnmarshalBinary(data)
+
+		runtime.ReadMemStats(&after)
+		// Decoding may allocate proportionally to the input, never to a declared count
+		if grown := after.TotalAlloc - before.TotalAlloc; grown > uint64(64*len(data)+64*1024) {
+			t.Fatalf("Decoding %d bytes allocated %d bytes", len(data), grown)
+		}
+
+		if err != nil {
+			return
+		}
+
+		encoded, _ := set.MarshalBinary()
+		if !bytes.Equal(encoded, data) {
+			t.Fatalf("Accepted input is not in canonical form")
+		}
+
+		var again SignatureSet
+		if err := again.UnmarshalBinary(encoded); err != nil || again.Hash() != set.Hash() {
+			t.Fatalf("Canonical re-encoding does not decode to an equal set: %v", err)
+		}
+	})
+}
+
+// FuzzVerify checks that every verification entry point agrees on arbitrary input
+//
+// Seeds live in testdata/fuzz/FuzzVerify (valid, empty, short, long,
+// swapped-layout, small-order-key and non-canonical-S records) and are
+// replayed by plain go test; the KAT vectors are added on top.
+func FuzzVerify(f *testing.F) {
+	for _, kat := range KnownAnswerTests {
+		f.Add(kat.SignatureInfo(), kat.PayloadHash[:])
+	}
+
+	verifier := NewVerifier()
+	f.Fuzz(func(t *testing.T, signatureInfo []byte, hash []byte) {
+		var payloadHash [32]byte
+		copy(payloadHash[:], hash)
+
+		address, err := Verify(signatureInfo, payloadHash)
+
+		verifierAddress, verifierErr := verifier.Verify(signatureInfo, payloadHash)
+		if (err == nil) != (verifierErr == nil) || address != verifierAddress {
+			t.Fatalf("Verify and Verifier.Verify disagree: %v vs %v", err, verifierErr)
+		}
+
+		strictAddress, strictErr := VerifyStrict(signatureInfo, payloadHash)
+		if strictErr == nil && (err != nil || strictAddress != address) {
+			t.Fatalf("VerifyStrict accepted input Verify rejected: %v", err)
+		}
+
+		if err != nil {
+			return
+		}
+		if address != deriveAddress(signatureInfo[64:]) {
+			t.Fatalf("Address does not match the embedded public key")
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// KeyReuse selects how a Generator draws signing keys for successive items
+type KeyReuse string
+
+// Key reuse distributions
+const (
+	KeyReuseUniform    KeyReuse = "uniform"    // Every key equally likely
+	KeyReuseZipfian    KeyReuse = "zipfian"    // A few hot keys sign most items
+	KeyReuseSequential KeyReuse = "sequential" // Keys in order, wrapping around
+)
+
+// ItemLabel is the ground truth for a generated item
+type ItemLabel int
+
+// Item labels
+const (
+	ItemValid     ItemLabel = iota // Verifies to GeneratedItem.Address
+	ItemInvalid                    // Well-formed but the signature does not verify
+	ItemMalformed                  // signature_info is not MAX_SIZE bytes
+)
+
+// String returns the label name
+func (l ItemLabel) String() string {
+	switch l {
+	case ItemValid:
+		return "valid"
+	case ItemInvalid:
+		return "invalid"
+	case ItemMalformed:
+		return "malformed"
+	default:
+		return fmt.Sprintf("ItemLabel(%d)", int(l))
+	}
+}
+
+// GeneratorConfig configures a Generator
+type GeneratorConfig struct {
+	Seed          uint64   // Stream seed; equal seeds give identical streams
+	Keys          int      // Number of distinct signing keys; defaults to 64
+	KeyReuse      KeyReuse // Key distribution; defaults to KeyReuseUniform
+	InvalidRate   float64  // Share of items with a corrupted signature, in [0, 1]
+	MalformedRate float64  // Share of items with a wrong-length encoding, in [0, 1]
+}
+
+// GeneratedItem is a generated batch item with its expected outcome
+type GeneratedItem struct {
+	BatchItem
+	Label    ItemLabel
+	Address  ExecutionAddress // Signer's address; zero unless Label is ItemValid
+	KeyIndex int              // Index of the signing key, for reuse analysis
+}
+
+// Generator produces a deterministic stream of signed GeneratedItems
+//
+// It is the single source of synthetic signatures in this module: RunBench,
+// CalibrationRun and DeterministicVectors draw their inputs from it, and the
+// workload package exposes it to callers. Items are signed with Sign.
+//
+// A Generator is not safe for concurrent use. Create one with NewGenerator;
+// a nil or zero Generator yields zero-valued items.
+type Generator struct {
+	cfg   GeneratorConfig
+	rng   *rand.Rand
+	zipf  *rand.Zipf
+	keys  []ed25519.PrivateKey
+	count int
+}
+
+// NewGenerator creates a Generator, deriving its signing keys from cfg.Seed
+func NewGenerator(cfg GeneratorConfig) (*Generator, error) {
+	if cfg.Keys == 0 {
+		cfg.Keys = 64
+	}
+	if cfg.KeyReuse == "" {
+		cfg.KeyReuse = KeyReuseUniform
+	}
+	if cfg.Keys < 0 {
+		return nil, fmt.Errorf("invalid key count %d", cfg.Keys)
+	}
+	for _, rate := range []float64{cfg.InvalidRate, cfg.MalformedRate} {
+		if math.IsNaN(rate) || math.IsInf(rate, 0) {
+			return nil, fmt.Errorf("invalid rate %v: rates must be finite", rate)
+		}
+	}
+	if cfg.InvalidRate < 0 || cfg.MalformedRate < 0 || cfg.InvalidRate+cfg.MalformedRate > 1 {
+		return nil, errors.New("invalid and malformed rates must be non-negative and sum to at most 1")
+	}
+
+	g := &Generator{
+		cfg:  cfg,
+		rng:  rand.New(rand.NewPCG(cfg.Seed, cfg.Seed^0x9e3779b97f4a7c15)),
+		keys: make([]ed25519.PrivateKey, cfg.Keys),
+	}
+
+	switch cfg.KeyReuse {
+	case KeyReuseUniform, KeyReuseSequential:
+	case KeyReuseZipfian:
+		g.zipf = rand.NewZipf(g.rng, 1.1, 1, uint64(cfg.Keys-1))
+	default:
+		return nil, fmt.Errorf("unknown key reuse distribution %q", cfg.KeyReuse)
+	}
+
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range g.keys {
+		g.fill(seed)
+		g.keys[i] = ed25519.NewKeyFromSeed(seed)
+	}
+	return g, nil
+}
+
+// fill writes pseudo-random bytes from the stream into b
+func (g *Generator) fill(b []byte) {
+	for i := range b {
+		b[i] = byte(g.rng.Uint32())
+	}
+}
+
+// Next returns the next item of the stream
+func (g *Generator) Next() GeneratedItem {
+	if g == nil || len(g.keys) == 0 {
+		return GeneratedItem{}
+	}
+
+	var keyIndex int
+	switch {
+	case g.zipf != nil:
+		keyIndex = int(g.zipf.Uint64())
+	case g.cfg.KeyReuse == KeyReuseSequential:
+		keyIndex = g.count % len(g.keys)
+	default:
+		keyIndex = g.rng.IntN(len(g.keys))
+	}
+	key := g.keys[keyIndex]
+	g.count++
+
+	var payloadHash [32]byte
+	g.fill(payloadHash[:])
+
+	// The key is well-formed and AllowZeroHash lifts the only other check, so this cannot fail
+	signatureInfo, _ := SignOptions{AllowZeroHash: true}.Sign(key, payloadHash)
+
+	item := GeneratedItem{
+		BatchItem: BatchItem{SignatureInfo: signatureInfo, PayloadHash: payloadHash},
+		KeyIndex:  keyIndex,
+	}
+
+	switch roll := g.rng.Float64(); {
+	case roll < g.cfg.MalformedRate:
+		item.Label = ItemMalformed
+		length := g.rng.IntN(2 * MAX_SIZE)
+		if length >= MAX_SIZE {
+			length++
+		}
+		resized := make([]byte, length)
+		copy(resized, signatureInfo)
+		item.SignatureInfo = resized
+	case roll < g.cfg.MalformedRate+g.cfg.InvalidRate:
+		item.Label = ItemInvalid
+		// Flip one bit of R; the result verifies only with negligible probability
+		signatureInfo[g.rng.IntN(32)] ^= 1 << g.rng.IntN(8)
+	default:
+		item.Label = ItemValid
+		item.Address = deriveAddress(signatureInfo[64:])
+	}
+
+	return item
+}
+
+// Items returns the next n items of the stream
+func (g *Generator) Items(n int) []GeneratedItem {
+	items := make([]GeneratedItem, max(n, 0))
+	for i := range items {
+		items[i] = g.Next()
+	}
+	return items
+}
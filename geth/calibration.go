@@ -0,0 +1,52 @@
+package geth
+
+import (
+	"fmt"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ecrecoverInputs is the number of distinct signatures an ecrecover baseline cycles through
+const ecrecoverInputs = 256
+
+// EcrecoverBaseline returns an eip7980.CalibrationConfig.Baseline that runs go-ethereum's crypto.Ecrecover
+//
+// Signatures over random hashes are made with fresh secp256k1 keys before it
+// returns, so the baseline times only the recovery, the work the ecrecover
+// precompile does for its 3000 gas.
+func EcrecoverBaseline() (func(i int), error) {
+	hashes := make([][]byte, ecrecoverInputs)
+	signatures := make([][]byte, ecrecoverInputs)
+	for i := range hashes {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = crypto.Keccak256(crypto.FromECDSA(key))
+		if signatures[i], err = crypto.Sign(hashes[i], key); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(i int) {
+		j := i % ecrecoverInputs
+		if _, err := crypto.Ecrecover(hashes[j], signatures[j]); err != nil {
+			panic(fmt.Sprintf("geth: ecrecover baseline failed: %v", err))
+		}
+	}, nil
+}
+
+// CalibrationRun runs eip7980.CalibrationRun with an EcrecoverBaseline
+//
+// The returned histogram carries the paired ecrecover timings in Baseline
+// and the Ed25519-to-ecrecover median ratio in Ratio. A Baseline already set
+// in cfg is replaced.
+func CalibrationRun(cfg eip7980.CalibrationConfig) (eip7980.Histogram, error) {
+	baseline, err := EcrecoverBaseline()
+	if err != nil {
+		return eip7980.Histogram{}, err
+	}
+	cfg.Baseline = baseline
+	return eip7980.CalibrationRun(cfg)
+}
@@ -0,0 +1,36 @@
+// Package geth adapts EIP-7980 types to go-ethereum's
+//
+// It is a separate module so the eip7980 module does not require
+// go-ethereum: only callers that import this package pull it into their
+// module graph.
+package geth
+
+import (
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// HexutilBytes returns the 96-byte encoding as go-ethereum's JSON-RPC byte type
+func HexutilBytes(s *eip7980.SignatureInfo) hexutil.Bytes {
+	return hexutil.Bytes(s.ToBytes())
+}
+
+// ParseSignatureInfoHexutil converts go-ethereum's JSON-RPC byte type into SignatureInfo
+func ParseSignatureInfoHexutil(data hexutil.Bytes) (*eip7980.SignatureInfo, error) {
+	return eip7980.ParseSignatureInfo(data)
+}
+
+// Common converts the address to go-ethereum's common.Address
+func Common(addr eip7980.ExecutionAddress) common.Address {
+	return common.Address(addr)
+}
+
+// VerifyCommon verifies like eip7980.Verify and returns the address as go-ethereum's common.Address
+func VerifyCommon(signatureInfo []byte, payloadHash [32]byte) (common.Address, error) {
+	address, err := eip7980.Verify(signatureInfo, payloadHash)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return Common(address), nil
+}
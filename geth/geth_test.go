@@ -0,0 +1,82 @@
+package geth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TestHexutilBytesRoundTrip tests conversion through go-ethereum's JSON encoding
+func TestHexutilBytesRoundTrip(t *testing.T) {
+	signatureInfo := eip7980.KnownAnswerTests[0].SignatureInfo()
+
+	sigInfo, err := eip7980.ParseSignatureInfo(signatureInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, err := json.Marshal(HexutilBytes(sigInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(encoded), `"0x`) {
+		t.Errorf("Unexpected JSON encoding %s", encoded)
+	}
+
+	var decoded hexutil.Bytes
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseSignatureInfoHexutil(decoded)
+	if err != nil {
+		t.Fatalf("ParseSignatureInfoHexutil failed: %v", err)
+	}
+	if !bytes.Equal(parsed.ToBytes(), signatureInfo) {
+		t.Errorf("Round trip changed signature info")
+	}
+
+	if _, err := ParseSignatureInfoHexutil(decoded[:95]); err == nil {
+		t.Errorf("Expected error for short input")
+	}
+}
+
+// TestVerifyCommon tests conversion to go-ethereum's address type
+func TestVerifyCommon(t *testing.T) {
+	kat := eip7980.KnownAnswerTests[0]
+
+	address, err := VerifyCommon(kat.SignatureInfo(), kat.PayloadHash)
+	if err != nil {
+		t.Fatalf("VerifyCommon failed: %v", err)
+	}
+
+	if address != Common(kat.Address) || address.Bytes()[19] != kat.Address[19] {
+		t.Errorf("Address = %s, want %s", address, kat.Address)
+	}
+	if !strings.EqualFold(address.Hex(), kat.Address.String()) {
+		t.Errorf("Hex() = %s, want %s", address.Hex(), kat.Address)
+	}
+
+	if _, err := VerifyCommon(kat.SignatureInfo(), [32]byte{1}); !errors.Is(err, eip7980.ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+// TestCalibrationRun tests that the ecrecover baseline is measured and compared
+func TestCalibrationRun(t *testing.T) {
+	histogram, err := CalibrationRun(eip7980.CalibrationConfig{N: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histogram.Baseline == nil || histogram.Baseline.Total != 20 || histogram.Baseline.P50 <= 0 {
+		t.Fatalf("Unexpected baseline %+v", histogram.Baseline)
+	}
+	if histogram.Ratio <= 0 {
+		t.Errorf("Expected a positive ratio, got %v", histogram.Ratio)
+	}
+}
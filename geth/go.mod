@@ -0,0 +1,18 @@
+module github.com/EIPs-CodeLab/eip-7980/geth
+
+go 1.24.5
+
+require (
+	github.com/EIPs-CodeLab/eip-7980 v0.0.0
+	github.com/ethereum/go-ethereum v1.16.5
+)
+
+require (
+	filippo.io/edwards25519 v1.2.0 // indirect
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1 // indirect
+	github.com/holiman/uint256 v1.3.2 // indirect
+	golang.org/x/crypto v0.43.0 // indirect
+	golang.org/x/sys v0.37.0 // indirect
+)
+
+replace github.com/EIPs-CodeLab/eip-7980 => ../
@@ -3,6 +3,8 @@ module github.com/EIPs-CodeLab/eip-7980
 go 1.24.5
 
 require (
-	golang.org/x/crypto v0.43.0 // indirect
-	golang.org/x/sys v0.37.0 // indirect
+	filippo.io/edwards25519 v1.2.0
+	golang.org/x/crypto v0.43.0
 )
+
+require golang.org/x/sys v0.37.0 // indirect
@@ -0,0 +1,75 @@
+package eip7980
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned by ParseHash32, one per way a hash string can be malformed
+var (
+	ErrHashNotHex    = errors.New("payload hash contains non-hex characters")
+	ErrHashOddLength = errors.New("payload hash has an odd number of hex characters")
+	ErrHashLength    = errors.New("payload hash has the wrong length")
+)
+
+// Hash32 is a 32-byte payload hash that encodes as 0x-prefixed hex text
+type Hash32 [32]byte
+
+// ParseHash32 parses a payload hash of exactly 64 hex characters, with or without a 0x prefix
+//
+// Errors wrap ErrHashNotHex, ErrHashOddLength or ErrHashLength and state the
+// expected length alongside the received one, so a truncated paste is
+// obvious from the message alone.
+func ParseHash32(s string) ([32]byte, error) {
+	var hash [32]byte
+
+	digits := s
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits = digits[2:]
+	}
+
+	for i := 0; i < len(digits); i++ {
+		if !isHexDigit(digits[i]) {
+			return hash, fmt.Errorf("%w: %q at position %d", ErrHashNotHex, digits[i], i)
+		}
+	}
+	if len(digits)%2 != 0 {
+		return hash, fmt.Errorf("%w: expected exactly %d hex characters, got %d", ErrHashOddLength, 2*len(hash), len(digits))
+	}
+	if len(digits) != 2*len(hash) {
+		return hash, fmt.Errorf("%w: expected exactly %d hex characters, got %d", ErrHashLength, 2*len(hash), len(digits))
+	}
+
+	hex.Decode(hash[:], []byte(digits))
+	return hash, nil
+}
+
+// isHexDigit reports whether c is an ASCII hex digit in either case
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// String returns the 0x-prefixed lowercase hex form of the hash
+func (h Hash32) String() string {
+	return fmt.Sprintf("0x%x", h[:])
+}
+
+// MarshalText encodes the hash in the form returned by String
+func (h Hash32) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText decodes a hash with ParseHash32
+func (h *Hash32) UnmarshalText(text []byte) error {
+	if h == nil {
+		return errors.New("nil Hash32 destination")
+	}
+	parsed, err := ParseHash32(string(text))
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
@@ -0,0 +1,61 @@
+package eip7980
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// hashParseCases are run through ParseHash32 and the Hash32 JSON decoder
+var hashParseCases = []struct {
+	name    string
+	input   string
+	err     error
+	message string
+}{
+	{"prefixed", "0x" + strings.Repeat("ab", 32), nil, ""},
+	{"bare", strings.Repeat("AB", 32), nil, ""},
+	{"uppercase prefix", "0X" + strings.Repeat("0f", 32), nil, ""},
+	{"truncated", "0x" + strings.Repeat("a", 63), ErrHashOddLength, "expected exactly 64 hex characters, got 63"},
+	{"short", strings.Repeat("a", 62), ErrHashLength, "expected exactly 64 hex characters, got 62"},
+	{"long", strings.Repeat("a", 66), ErrHashLength, "expected exactly 64 hex characters, got 66"},
+	{"empty", "", ErrHashLength, "expected exactly 64 hex characters, got 0"},
+	{"prefix only", "0x", ErrHashLength, "got 0"},
+	{"non-hex", "0x" + strings.Repeat("a", 63) + "g", ErrHashNotHex, `'g' at position 63`},
+	{"non-hex odd", strings.Repeat("z", 63), ErrHashNotHex, `'z' at position 0`},
+	{"whitespace", " " + strings.Repeat("a", 64), ErrHashNotHex, `' ' at position 0`},
+}
+
+// TestParseHash32 tests accepted forms, error codes and messages
+func TestParseHash32(t *testing.T) {
+	for _, tc := range hashParseCases {
+		hash, err := ParseHash32(tc.input)
+		if !matchesSentinel(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+			continue
+		}
+		if err != nil && !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%s: expected message containing %q, got %q", tc.name, tc.message, err)
+		}
+		if err == nil && Hash32(hash).String() != "0x"+strings.ToLower(tc.input[len(tc.input)-64:]) {
+			t.Errorf("%s: decoded %x", tc.name, hash)
+		}
+	}
+}
+
+// TestHash32JSON tests that JSON decoding reports the same errors as ParseHash32
+func TestHash32JSON(t *testing.T) {
+	for _, tc := range hashParseCases {
+		quoted, _ := json.Marshal(tc.input)
+		var hash Hash32
+		err := json.Unmarshal(quoted, &hash)
+		if !matchesSentinel(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+
+	data, _ := json.Marshal(Hash32{0xab})
+	if string(data) != `"0xab`+strings.Repeat("0", 62)+`"` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+}
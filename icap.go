@@ -0,0 +1,114 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// icapDirectLength is the BBAN length of a Direct ICAP; addresses that need
+// 31 base36 digits produce the 34-character Basic form instead
+const icapDirectLength = 30
+
+// icapIndirectLength is the length of an Indirect ICAP (XE, check digits, asset, institution, client)
+const icapIndirectLength = 20
+
+// ICAP parse errors; ErrICAPChecksum and ErrICAPIndirect wrap ErrInvalidICAP
+var (
+	ErrInvalidICAP  = errors.New("invalid ICAP address")
+	ErrICAPChecksum = fmt.Errorf("%w: checksum mismatch", ErrInvalidICAP)
+	ErrICAPIndirect = fmt.Errorf("%w: indirect (institution/client) form needs a name registry and is not supported", ErrInvalidICAP)
+)
+
+// ICAP returns the Inter exchange Client Address Protocol (IBAN-compatible) form of the address
+//
+// The address is encoded as uppercase base36, zero-padded to 30 digits, and
+// prefixed with the "XE" country code and two ISO 7064 mod 97-10 check
+// digits. Addresses below 2^155 give the 34-character Direct form; larger
+// ones need 31 digits and give the 35-character Basic form.
+func (addr ExecutionAddress) ICAP() string {
+	bban := strings.ToUpper(new(big.Int).SetBytes(addr[:]).Text(36))
+	if len(bban) < icapDirectLength {
+		bban = strings.Repeat("0", icapDirectLength-len(bban)) + bban
+	}
+	return "XE" + icapCheckDigits(bban) + bban
+}
+
+// ParseICAP parses a Direct (34-character) or Basic (35-character) ICAP address
+//
+// Input is case-insensitive and may contain spaces, as when read aloud or
+// printed in groups. Indirect ICAPs (20 characters, XE..ETH...) resolve
+// through an on-chain registry and fail with ErrICAPIndirect; a wrong check
+// digit fails with ErrICAPChecksum.
+func ParseICAP(s string) (ExecutionAddress, error) {
+	icap := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if !strings.HasPrefix(icap, "XE") {
+		return ExecutionAddress{}, fmt.Errorf("%w: missing XE country code", ErrInvalidICAP)
+	}
+	for i := 2; i < len(icap); i++ {
+		if c := icap[i]; (c < '0' || c > '9') && (c < 'A' || c > 'Z') {
+			return ExecutionAddress{}, fmt.Errorf("%w: invalid character %q", ErrInvalidICAP, c)
+		}
+	}
+
+	switch len(icap) {
+	case icapIndirectLength:
+		return ExecutionAddress{}, ErrICAPIndirect
+	case 4 + icapDirectLength, 4 + icapDirectLength + 1:
+	default:
+		return ExecutionAddress{}, fmt.Errorf("%w: expected 34 (direct) or 35 (basic) characters, got %d", ErrInvalidICAP, len(icap))
+	}
+	if icap[2] > '9' || icap[3] > '9' {
+		return ExecutionAddress{}, fmt.Errorf("%w: check digits must be numeric", ErrInvalidICAP)
+	}
+	if icapMod97(icap[4:]+icap[:4]) != 1 {
+		return ExecutionAddress{}, ErrICAPChecksum
+	}
+
+	value, _ := new(big.Int).SetString(icap[4:], 36)
+	if value.BitLen() > 8*len(ExecutionAddress{}) {
+		return ExecutionAddress{}, fmt.Errorf("%w: value exceeds 160 bits", ErrInvalidICAP)
+	}
+
+	var addr ExecutionAddress
+	value.FillBytes(addr[:])
+	return addr, nil
+}
+
+// Format implements fmt.Formatter so %q prints the quoted ICAP form
+//
+// %s, %v, %x and %X format String() as before; other verbs see the raw
+// 20-byte array.
+func (addr ExecutionAddress) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), addr.ICAP())
+	case verb == 's' || verb == 'x' || verb == 'X' || (verb == 'v' && !f.Flag('#')):
+		fmt.Fprintf(f, fmt.FormatString(f, verb), addr.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), [20]byte(addr))
+	}
+}
+
+// icapCheckDigits computes the IBAN check digits for an "XE" BBAN
+func icapCheckDigits(bban string) string {
+	mod := icapMod97(bban + "XE00")
+	check := 98 - mod
+	return string([]byte{byte('0' + check/10), byte('0' + check%10)})
+}
+
+// icapMod97 computes the IBAN mod 97 of s, mapping letters A-Z to 10-35
+func icapMod97(s string) int {
+	mod := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			value := int(c-'A') + 10
+			mod = (mod*100 + value) % 97
+		} else {
+			mod = (mod*10 + int(c-'0')) % 97
+		}
+	}
+	return mod
+}
@@ -0,0 +1,120 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestICAP tests ICAP encoding against the published Direct ICAP example
+func TestICAP(t *testing.T) {
+	addr, err := ParseAddress("0x00c5496aee77c1ba1f0854206a26dda82a81d6d8", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := addr.ICAP(), "XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZS"; got != want {
+		t.Errorf("ICAP = %s, want %s", got, want)
+	}
+
+	// The check digits make the whole IBAN congruent to 1 mod 97
+	for _, address := range []ExecutionAddress{{}, {19: 1}, KnownAnswerTests[0].Address} {
+		icap := address.ICAP()
+		if icapMod97(icap[4:]+icap[:4]) != 1 {
+			t.Errorf("ICAP %s fails the IBAN check", icap)
+		}
+	}
+}
+
+// TestParseICAPRoundTrip tests Direct and Basic round trips, including the largest address
+func TestParseICAPRoundTrip(t *testing.T) {
+	var maxAddress ExecutionAddress
+	for i := range maxAddress {
+		maxAddress[i] = 0xff
+	}
+
+	addresses := []ExecutionAddress{{}, {19: 1}, maxAddress, KnownAnswerTests[0].Address}
+	for _, vector := range DeterministicVectors(20) {
+		addresses = append(addresses, vector.Address)
+	}
+
+	sawBasic := false
+	for _, address := range addresses {
+		icap := address.ICAP()
+		sawBasic = sawBasic || len(icap) == 35
+
+		got, err := ParseICAP(icap)
+		if err != nil || got != address {
+			t.Errorf("ParseICAP(%s) = %s, %v; want %s", icap, got, err, address)
+		}
+
+		spaced := strings.ToLower(icap[:4] + " " + icap[4:])
+		if got, err := ParseICAP(spaced); err != nil || got != address {
+			t.Errorf("ParseICAP(%q) = %s, %v; want %s", spaced, got, err, address)
+		}
+	}
+	if !sawBasic {
+		t.Errorf("Expected at least one 35-character Basic ICAP among the vectors")
+	}
+}
+
+// TestParseICAPRejects tests checksum corruption, indirect and malformed inputs
+func TestParseICAPRejects(t *testing.T) {
+	const direct = "XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZS"
+
+	// Every single-character substitution is caught by mod 97
+	for i := 2; i < len(direct); i++ {
+		for _, c := range []byte("07AZ") {
+			if c == direct[i] || (i < 4 && c > '9') {
+				continue
+			}
+			corrupted := direct[:i] + string(c) + direct[i+1:]
+			if _, err := ParseICAP(corrupted); !errors.Is(err, ErrICAPChecksum) {
+				t.Errorf("%s: expected ErrICAPChecksum, got %v", corrupted, err)
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		icap string
+		err  error
+	}{
+		{"XE81ETHXREGGAVOFYORK", ErrICAPIndirect},
+		{"GB82WEST12345698765432", ErrInvalidICAP},
+		{"XE7338O073KYGTWWZN0F2WZ0R8PX5ZPPZ", ErrInvalidICAP},
+		{"XE7338O073KYGTWWZN0F2WZ0R8PX5ZPP-S", ErrInvalidICAP},
+		{"XEAB38O073KYGTWWZN0F2WZ0R8PX5ZPPZS", ErrInvalidICAP},
+		{"", ErrInvalidICAP},
+	} {
+		if _, err := ParseICAP(tc.icap); !errors.Is(err, tc.err) {
+			t.Errorf("%q: expected %v, got %v", tc.icap, tc.err, err)
+		}
+	}
+
+	// A 31-digit BBAN above 2^160 passes the checksum but is not an address
+	overflow := strings.Repeat("Z", 31)
+	if _, err := ParseICAP("XE" + icapCheckDigits(overflow) + overflow); !errors.Is(err, ErrInvalidICAP) || errors.Is(err, ErrICAPChecksum) {
+		t.Errorf("Expected out-of-range ErrInvalidICAP, got %v", err)
+	}
+}
+
+// TestAddressFormat tests that %q prints the ICAP and other verbs are unchanged
+func TestAddressFormat(t *testing.T) {
+	addr := KnownAnswerTests[0].Address
+
+	for _, tc := range []struct {
+		format string
+		want   string
+	}{
+		{"%q", `"` + addr.ICAP() + `"`},
+		{"%s", addr.String()},
+		{"%v", addr.String()},
+		{"%x", fmt.Sprintf("%x", addr.String())},
+		{"%50s", fmt.Sprintf("%50s", addr.String())},
+		{"%d", fmt.Sprintf("%d", [20]byte(addr))},
+	} {
+		if got := fmt.Sprintf(tc.format, addr); got != tc.want {
+			t.Errorf("%s: expected %s, got %s", tc.format, tc.want, got)
+		}
+	}
+}
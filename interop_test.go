@@ -0,0 +1,250 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// Solana keypair in nacl format: the 64-byte secretKey is seed || publicKey,
+// exactly as produced by nacl.sign.keyPair.fromSeed and stored by solana-keygen.
+// The secret key and both signatures below were produced outside this package
+// by tweetnacl-js 0.14.5, the nacl implementation solana-web3.js 1.x signs
+// Keypair transactions with, under Node.js 20:
+//
+//	kp = nacl.sign.keyPair.fromSeed(seed)
+//	nacl.sign.detached(payloadHash, kp.secretKey) // solanaSignatureHex
+//	nacl.sign.detached(message, kp.secretKey)     // solanaMessageSignatureHex
+const (
+	solanaSecretKeyHex = "9da4abb2b9c0c7ced5dce3eaf1f8ff060d141b222930373e454c535a61686f76" +
+		"b7365aacadf274071867ff0ada5a82b30363a4afeaa4d21c3033268dc548f836"
+	solanaAddress        = "DLBgRuepxKuGxqz1sGjQZFYEkHvb4Xy1RFDrQ6pYaYCd"
+	solanaPayloadHashHex = "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"
+	solanaSignatureHex   = "e22c9ee8ba24fa1133515442c7d789b8e7621e83665573262b3bf2023f9a83b1" +
+		"20218fbc08c212ca427bbbfa373aaf92f457abf50928c4e0af0dca96e07df60e"
+	solanaExpectedAddress = "0xb528f2e9c0577742403c3ffe24acfaf33e469ddc"
+)
+
+// base58Encode encodes b with the Bitcoin alphabet used for Solana addresses
+func base58Encode(b []byte) string {
+	const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append([]byte{alphabet[mod.Int64()]}, out...)
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append([]byte{alphabet[0]}, out...)
+	}
+	return string(out)
+}
+
+// mustDecodeHex decodes a hex test fixture
+func mustDecodeHex(t testing.TB, s string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// TestSolanaInterop tests that a nacl-signed Solana payload hash verifies
+func TestSolanaInterop(t *testing.T) {
+	secretKey := mustDecodeHex(t, solanaSecretKeyHex)
+	publicKey := secretKey[32:]
+	signature := mustDecodeHex(t, solanaSignatureHex)
+
+	var payloadHash [32]byte
+	copy(payloadHash[:], mustDecodeHex(t, solanaPayloadHashHex))
+
+	if got := base58Encode(publicKey); got != solanaAddress {
+		t.Fatalf("Solana address = %s, want %s", got, solanaAddress)
+	}
+
+	// The nacl secretKey layout matches crypto/ed25519's private key layout
+	if !ed25519.NewKeyFromSeed(secretKey[:32]).Equal(ed25519.PrivateKey(secretKey)) {
+		t.Fatal("Secret key does not match its seed")
+	}
+
+	signatureInfo := make([]byte, MAX_SIZE)
+	copy(signatureInfo[:64], signature)
+	copy(signatureInfo[64:], publicKey)
+
+	address, err := Verify(signatureInfo, payloadHash)
+	if err != nil {
+		t.Fatalf("Solana signature rejected: %v", err)
+	}
+
+	if address.String() != solanaExpectedAddress {
+		t.Errorf("Address = %s, want %s", address, solanaExpectedAddress)
+	}
+}
+
+// Legacy Solana message transferring 1,000,000 lamports from the keypair above
+// via the system program: header [1 0 1], three account keys (signer,
+// recipient, system program), recent blockhash, and one transfer instruction.
+// The signature is the tweetnacl-js detached signature over these raw bytes.
+const (
+	solanaMessageHex = "01000103" +
+		"b7365aacadf274071867ff0ada5a82b30363a4afeaa4d21c3033268dc548f836" +
+		"404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"c0c1c2c3c4c5c6c7c8c9cacbcccdcecfd0d1d2d3d4d5d6d7d8d9dadbdcdddedf" +
+		"01020200010c0200000040420f0000000000"
+	solanaMessageSignatureHex = "da0d4eee53ef1d8a0a029aff5425a2ddd364b7798224738bc4796325f36e9e06" +
+		"529242f634750c5314da8d54e1668966b2065965e8a1d8a51ad0f213ad582e06"
+)
+
+// TestVerifySolanaMessage tests verification over a raw Solana message
+func TestVerifySolanaMessage(t *testing.T) {
+	message := mustDecodeHex(t, solanaMessageHex)
+
+	var sig [64]byte
+	var pub [32]byte
+	copy(sig[:], mustDecodeHex(t, solanaMessageSignatureHex))
+	copy(pub[:], mustDecodeHex(t, solanaSecretKeyHex)[32:])
+
+	address, err := VerifySolanaMessage(sig, pub, message)
+	if err != nil {
+		t.Fatalf("VerifySolanaMessage failed: %v", err)
+	}
+	if address.String() != solanaExpectedAddress {
+		t.Errorf("Address = %s, want %s", address, solanaExpectedAddress)
+	}
+
+	message[len(message)-1] ^= 0x01
+	if _, err := VerifySolanaMessage(sig, pub, message); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for altered message, got %v", err)
+	}
+
+	var payloadHash [32]byte
+	copy(payloadHash[:], mustDecodeHex(t, solanaPayloadHashHex))
+	copy(sig[:], mustDecodeHex(t, solanaSignatureHex))
+	if _, err := VerifySolanaMessage(sig, pub, payloadHash[:]); err != ErrNotPayloadHash {
+		t.Errorf("Expected ErrNotPayloadHash, got %v", err)
+	}
+
+	// Keys are validated like Verify's and signatures go through the Backend
+	message[len(message)-1] ^= 0x01
+	copy(sig[:], mustDecodeHex(t, solanaMessageSignatureHex))
+	for name, invalid := range invalidPublicKeyVectors() {
+		var badKey [32]byte
+		copy(badKey[:], invalid)
+		if _, err := VerifySolanaMessage(sig, badKey, message); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%s: expected ErrInvalidPublicKey, got %v", name, err)
+		}
+	}
+	backend := installCountingBackend(t)
+	if _, err := VerifySolanaMessage(sig, pub, message); err != nil || backend.calls != 1 {
+		t.Errorf("Expected one Backend call, got %d, %v", backend.calls, err)
+	}
+}
+
+// RFC 8032 Section 7.1 test vectors TEST 1-3 (Ed25519): public key, message
+// and signature, copied from the RFC text. They were produced independently of
+// this package, so they pin VerifySolanaMessage to the specification rather
+// than to this package's own signer. The messages are raw bytes, as Solana
+// signs them.
+var rfc8032Vectors = []struct {
+	name, publicKey, message, signature string
+}{
+	{
+		name:      "TEST 1",
+		publicKey: "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		message:   "",
+		signature: "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e06522490155" +
+			"5fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	},
+	{
+		name:      "TEST 2",
+		publicKey: "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		message:   "72",
+		signature: "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da" +
+			"085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+	},
+	{
+		name:      "TEST 3",
+		publicKey: "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		message:   "af82",
+		signature: "6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac" +
+			"18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a",
+	},
+}
+
+// TestVerifySolanaMessageRFC8032 tests VerifySolanaMessage against the RFC 8032 test vectors
+func TestVerifySolanaMessageRFC8032(t *testing.T) {
+	for _, vector := range rfc8032Vectors {
+		var sig [64]byte
+		var pub [32]byte
+		copy(sig[:], mustDecodeHex(t, vector.signature))
+		copy(pub[:], mustDecodeHex(t, vector.publicKey))
+		message := mustDecodeHex(t, vector.message)
+
+		address, err := VerifySolanaMessage(sig, pub, message)
+		if err != nil {
+			t.Errorf("%s: VerifySolanaMessage failed: %v", vector.name, err)
+			continue
+		}
+		if address != deriveAddress(pub[:]) {
+			t.Errorf("%s: Address = %s, want %s", vector.name, address, deriveAddress(pub[:]))
+		}
+
+		sig[63] ^= 0x01
+		if _, err := VerifySolanaMessage(sig, pub, message); err != ErrInvalidSignature {
+			t.Errorf("%s: expected ErrInvalidSignature for altered signature, got %v", vector.name, err)
+		}
+	}
+}
+
+// Reference Ed25519 vector from line 33 of the SUPERCOP sign.input file
+// (djb's ed25519 reference test data, shipped as crypto/ed25519's
+// testdata/sign.input.gz and run by libsodium's test/default/sign.c). It is
+// the first entry with a 32-byte message, so it has the shape of a payload
+// hash. The file stores seed || publicKey, publicKey, the message and the
+// combined signature || message; the detached signature is the first 64 bytes
+// of the last field, which is what crypto_sign_detached returns.
+const (
+	sodiumPublicKeyHex = "b49f3a78b1c6a7fca8f3466f33bc0e929f01fba04306c2a7465f46c3759316d9"
+	sodiumMessageHex   = "a750c232933dc14b1184d86d8b4ce72e16d69744ba69818b6ac33b1d823bb2c3"
+	sodiumSignatureHex = "04266c033b91c1322ceb3446c901ffcf3cc40c4034e887c9597ca1893ba7330b" +
+		"ecbbd8b48142ef35c012c6ba51a66df9308cb6268ad6b1e4b03e70102495790b"
+	sodiumExpectedAddress = "0xc995b19a9f4d73304bf37ad9e14875273326077c"
+)
+
+// TestLibsodiumInterop tests that a reference crypto_sign_detached signature over a 32-byte message verifies
+func TestLibsodiumInterop(t *testing.T) {
+	publicKey := mustDecodeHex(t, sodiumPublicKeyHex)
+	signature := mustDecodeHex(t, sodiumSignatureHex)
+
+	var payloadHash [32]byte
+	copy(payloadHash[:], mustDecodeHex(t, sodiumMessageHex))
+
+	signatureInfo := make([]byte, MAX_SIZE)
+	copy(signatureInfo[:64], signature)
+	copy(signatureInfo[64:], publicKey)
+
+	address, err := Verify(signatureInfo, payloadHash)
+	if err != nil {
+		t.Fatalf("libsodium signature rejected: %v", err)
+	}
+	if address.String() != sodiumExpectedAddress {
+		t.Errorf("Address = %s, want %s", address, sodiumExpectedAddress)
+	}
+
+	payloadHash[31] ^= 0x01
+	if _, err := Verify(signatureInfo, payloadHash); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for altered message, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package eip7980
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// KAT is a known-answer test vector for an EIP-7980 verifier
+//
+// Valid vectors must verify to Address. Invalid vectors must be rejected
+// with any non-nil error; Address is the zero address for them.
+type KAT struct {
+	Name        string
+	PublicKey   [32]byte
+	PayloadHash [32]byte
+	Signature   [64]byte
+	Address     ExecutionAddress
+	Valid       bool
+}
+
+// SignatureInfo returns the 96-byte signature_info encoding of the vector
+func (k KAT) SignatureInfo() []byte {
+	return NewSignatureInfoFromArrays(k.Signature, k.PublicKey).ToBytes()
+}
+
+// MarshalJSON encodes the vector with its byte fields as 0x-prefixed lowercase hex
+//
+// The encoding carries the signature_info as well as its halves, so a
+// consumer can feed it to a verifier without reassembling it.
+func (k KAT) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name          string `json:"name"`
+		PublicKey     string `json:"publicKey"`
+		PayloadHash   string `json:"payloadHash"`
+		Signature     string `json:"signature"`
+		SignatureInfo string `json:"signatureInfo"`
+		Address       string `json:"address"`
+		Valid         bool   `json:"valid"`
+	}{
+		Name:          k.Name,
+		PublicKey:     "0x" + hex.EncodeToString(k.PublicKey[:]),
+		PayloadHash:   "0x" + hex.EncodeToString(k.PayloadHash[:]),
+		Signature:     "0x" + hex.EncodeToString(k.Signature[:]),
+		SignatureInfo: "0x" + hex.EncodeToString(k.SignatureInfo()),
+		Address:       k.Address.String(),
+		Valid:         k.Valid,
+	})
+}
+
+// KnownAnswerTests is a conformance kit other EIP-7980 implementations can run against their verifier
+//
+// Signing keys are RFC 8032 seeds (0x00..., 0x01..., 0x42... repeated, and
+// RFC 8032 test 1); signatures are deterministic per RFC 8032.
+var KnownAnswerTests = []KAT{
+	newKAT("valid zero hash",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"3da1ebdfa96edd181dbe3659d1c051c431f056a5ad6a97a60d5cca10460438783546461e31285fc59f91c7072642745061e2451d5ff33bccd8c3c74dabcaf60a",
+		"431540d1f4faecdc7f7259797f629530995e7c6c", true),
+	newKAT("valid all-ones hash",
+		"8a88e3dd7409f195fd52db2d3cba5d72ca6709bf1d94121bf3748801b40f6f5c",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"e9f5a3bf46d30e1078dc67bfbfe2a1fcf4b3b482bebc28c4d943b9797bb43768b96054e0e016ab0857041ada6d1f738a2184ac7067a954f18deead1f3fb3850d",
+		"97b1c813eae702332ba3eaa1625f942c5472626d", true),
+	newKAT("valid RFC 8032 test 1 key",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		"9cce356a79dbaa5441dad52107b2a80529c968c99394179f40eec883ee4461c7ebfeb1a92dcacec78fa55bd8a4fc6a684fafb9fadd10d7c5720c6b59967a9b07",
+		"f7cc70adc63659b5d37671dc2b588db32446684a", true),
+	newKAT("valid sequential hash",
+		"2152f8d19b791d24453242e15f2eab6cb7cffa7b6a5ed30097960e069881db12",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"1bbdbb894c1f0026792d3cba5c74eed9e8c00d09680de18e8aea654ebb007f77a642da6cae45a6c782601b719acf972a984486fcf1a8e89e416f1a4679608c0e",
+		"40be53a7918f272a872cfad97b66506a4a99d617", true),
+	newKAT("valid same key different hash",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"431540d1f4faecdc7f7259797f629530995e7c6c", true),
+	newKAT("tampered R",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cc7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"", false),
+	newKAT("tampered S",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723314952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"", false),
+	newKAT("wrong payload hash",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"", false),
+	newKAT("wrong public key",
+		"8a88e3dd7409f195fd52db2d3cba5d72ca6709bf1d94121bf3748801b40f6f5c",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"", false),
+	newKAT("non-canonical S (S + L)",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da5544631189e06f96b1b5a39ca08b18cc53ec649c8410332bfab755b12d0f786b7b2f14518",
+		"", false),
+	newKAT("all-zero signature",
+		"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		"", false),
+	newKAT("public key not on curve",
+		"0200000000000000000000000000000000000000000000000000000000000000",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"", false),
+	newKAT("non-canonical public key (y = p)",
+		"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508",
+		"", false),
+}
+
+// newKAT builds a vector from hex fields; an empty address means the zero address
+func newKAT(name, publicKey, payloadHash, signature, address string, valid bool) KAT {
+	kat := KAT{Name: name, Valid: valid}
+	mustDecodeInto(kat.PublicKey[:], publicKey)
+	mustDecodeInto(kat.PayloadHash[:], payloadHash)
+	mustDecodeInto(kat.Signature[:], signature)
+	if address != "" {
+		mustDecodeInto(kat.Address[:], address)
+	}
+	return kat
+}
+
+// mustDecodeInto decodes a fixed-width hex literal, panicking on malformed input
+func mustDecodeInto(dst []byte, s string) {
+	if n, err := hex.Decode(dst, []byte(s)); err != nil || n != len(dst) {
+		panic(fmt.Sprintf("eip7980: bad KAT literal %q", s))
+	}
+}
+
+// RunKATs runs KnownAnswerTests against verify
+//
+// Returns nil when every vector passes, or an error joining one failure per
+// mismatching vector.
+func RunKATs(verify func([]byte, [32]byte) (ExecutionAddress, error)) error {
+	if verify == nil {
+		return errors.New("nil verify function")
+	}
+	var failures []error
+	for _, kat := range KnownAnswerTests {
+		address, err := verify(kat.SignatureInfo(), kat.PayloadHash)
+		switch {
+		case kat.Valid && err != nil:
+			failures = append(failures, fmt.Errorf("%s: expected valid, got %w", kat.Name, err))
+		case kat.Valid && address != kat.Address:
+			failures = append(failures, fmt.Errorf("%s: expected address %x, got %x", kat.Name, kat.Address, address))
+		case !kat.Valid && err == nil:
+			failures = append(failures, fmt.Errorf("%s: expected rejection, got address %x", kat.Name, address))
+		}
+	}
+	return errors.Join(failures...)
+}
@@ -0,0 +1,38 @@
+package eip7980
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRunKATs tests the known-answer vectors against every verification entry point
+func TestRunKATs(t *testing.T) {
+	if len(KnownAnswerTests) < 12 {
+		t.Errorf("Expected at least 12 vectors, got %d", len(KnownAnswerTests))
+	}
+
+	if err := RunKATs(Verify); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+	if err := RunKATs(NewVerifier().Verify); err != nil {
+		t.Errorf("Verifier.Verify: %v", err)
+	}
+}
+
+// TestRunKATsReportsFailures tests that a broken verifier is reported per vector
+func TestRunKATsReportsFailures(t *testing.T) {
+	acceptAll := func(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+		return deriveAddress(signatureInfo[64:]), nil
+	}
+
+	err := RunKATs(acceptAll)
+	if err == nil {
+		t.Fatal("Expected failures for an accept-all verifier")
+	}
+	if !strings.Contains(err.Error(), "tampered R: expected rejection") {
+		t.Errorf("Expected failure naming the vector, got %v", err)
+	}
+	if strings.Contains(err.Error(), "valid zero hash") {
+		t.Errorf("Valid vectors should pass an accept-all verifier, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// LabeledKey is an Ed25519 private key with a human-readable label and its derived address
+type LabeledKey struct {
+	Label      string
+	PrivateKey ed25519.PrivateKey
+	Address    ExecutionAddress
+}
+
+// NewLabeledKey labels privateKey and derives its EIP-7980 address
+func NewLabeledKey(label string, privateKey ed25519.PrivateKey) (LabeledKey, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return LabeledKey{}, fmt.Errorf("invalid private key length: expected %d, got %d", ed25519.PrivateKeySize, len(privateKey))
+	}
+
+	return LabeledKey{
+		Label:      label,
+		PrivateKey: privateKey,
+		Address:    deriveAddress(privateKey[ed25519.SeedSize:]),
+	}, nil
+}
+
+// genesisAllocEntry is one account in a genesis alloc fragment
+type genesisAllocEntry struct {
+	Balance string `json:"balance"`
+}
+
+// GenerateKeys generates n labeled keys "devnet-0" through "devnet-<n-1>" from random
+//
+// A nil random uses crypto/rand. Passing a deterministic stream, such as a
+// seeded XOF, makes the keys reproducible across runs.
+func GenerateKeys(n int, random io.Reader) ([]LabeledKey, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid key count %d", n)
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+
+	keys := make([]LabeledKey, n)
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range keys {
+		if _, err := io.ReadFull(random, seed); err != nil {
+			return nil, fmt.Errorf("key %d: %w", i, err)
+		}
+		keys[i], _ = NewLabeledKey(fmt.Sprintf("devnet-%d", i), ed25519.NewKeyFromSeed(seed))
+	}
+	return keys, nil
+}
+
+// WriteManifest writes a genesis alloc JSON fragment funding every key with fundWei
+//
+// The fragment maps each 0x-prefixed address to {"balance": "0x..."} and can
+// be merged into a go-ethereum genesis "alloc" object. Private keys are not
+// written; store them separately with ExportBundle.
+func WriteManifest(w io.Writer, keys []LabeledKey, fundWei *big.Int) error {
+	if w == nil {
+		return errNilWriter
+	}
+	if fundWei == nil || fundWei.Sign() < 0 {
+		return errors.New("fund amount must be a non-negative number of wei")
+	}
+
+	balance := "0x" + fundWei.Text(16)
+	alloc := make(map[string]genesisAllocEntry, len(keys))
+	for i, key := range keys {
+		address := key.Address.String()
+		if _, ok := alloc[address]; ok {
+			return fmt.Errorf("key %d (%s): duplicate address %s", i, key.Label, address)
+		}
+		alloc[address] = genesisAllocEntry{Balance: balance}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(alloc)
+}
@@ -0,0 +1,73 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// seededReader returns a deterministic byte stream for reproducible key generation
+func seededReader(seed string) *bytes.Reader {
+	stream := make([]byte, 64*ed25519.SeedSize)
+	sha3.ShakeSum256(stream, []byte(seed))
+	return bytes.NewReader(stream)
+}
+
+// TestGenerateKeysManifest tests the alloc JSON structure and that every address round-trips from its key
+func TestGenerateKeysManifest(t *testing.T) {
+	keys, err := GenerateKeys(20, seededReader("devnet"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := GenerateKeys(20, seededReader("devnet"))
+	for i := range keys {
+		if !keys[i].PrivateKey.Equal(again[i].PrivateKey) || keys[i].Label != again[i].Label {
+			t.Fatalf("Key %d is not reproducible from the seed", i)
+		}
+		if err := AssertKeyAddress(keys[i].PrivateKey.Public().(ed25519.PublicKey), keys[i].Address); err != nil {
+			t.Errorf("Key %d: %v", i, err)
+		}
+	}
+
+	fund, _ := new(big.Int).SetString("100000000000000000000", 10)
+	var out bytes.Buffer
+	if err := WriteManifest(&out, keys, fund); err != nil {
+		t.Fatal(err)
+	}
+
+	var alloc map[string]map[string]string
+	if err := json.Unmarshal(out.Bytes(), &alloc); err != nil {
+		t.Fatalf("Manifest is not a JSON object of objects: %v", err)
+	}
+	if len(alloc) != len(keys) {
+		t.Fatalf("Expected %d accounts, got %d", len(keys), len(alloc))
+	}
+	for _, key := range keys {
+		entry, ok := alloc[key.Address.String()]
+		if !ok {
+			t.Fatalf("Missing account for %s", key.Address)
+		}
+		if len(entry) != 1 || entry["balance"] != "0x56bc75e2d63100000" {
+			t.Errorf("Account %s: expected balance 0x56bc75e2d63100000, got %v", key.Address, entry)
+		}
+		if strings.Contains(out.String(), hex.EncodeToString(key.PrivateKey.Seed())) {
+			t.Errorf("Manifest leaks the private key for %s", key.Label)
+		}
+	}
+
+	if err := WriteManifest(&out, append(keys, keys[0]), fund); err == nil {
+		t.Errorf("Expected error for duplicate address")
+	}
+	if err := WriteManifest(&out, keys, big.NewInt(-1)); err == nil {
+		t.Errorf("Expected error for negative balance")
+	}
+	if _, err := GenerateKeys(2, bytes.NewReader(make([]byte, 40))); err == nil {
+		t.Errorf("Expected error for exhausted random source")
+	}
+}
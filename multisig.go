@@ -0,0 +1,211 @@
+package eip7980
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// SignatureSet encoding layout: 4-byte big-endian count followed by the
+// concatenated 96-byte signature_info entries in commitment order
+const setCountSize = 4
+
+// Errors returned by SignatureSet
+var (
+	ErrDuplicateSignature = errors.New("duplicate signature info in set")
+	ErrDuplicateSigner    = errors.New("duplicate signer public key in set")
+	ErrNonCanonicalSet    = errors.New("signature set entries are not in canonical order")
+)
+
+// Commitment returns the commitment hash of the signature info,
+// defined as keccak256(signature || public_key) over the 96-byte encoding.
+// A nil SignatureInfo has the zero commitment.
+func (s *SignatureInfo) Commitment() [32]byte {
+	if s == nil {
+		return [32]byte{}
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(s.Signature[:])
+	hash.Write(s.PublicKey[:])
+
+	var commitment [32]byte
+	hash.Sum(commitment[:0])
+	return commitment
+}
+
+// setEntry caches the commitment alongside its signature info
+type setEntry struct {
+	commitment [32]byte
+	info       SignatureInfo
+}
+
+// SignatureSet is a canonical container of signature infos for multisig payloads
+//
+// Entries are kept sorted by their commitment hash and duplicates are rejected,
+// so two sets holding the same signatures encode and hash identically
+// regardless of insertion order. Each public key may appear once: a signer
+// can make any number of distinct valid signatures with different nonces,
+// and counting them separately would let one key meet a threshold alone.
+type SignatureSet struct {
+	entries []setEntry
+}
+
+// NewSignatureSet builds a set from the given signature infos
+func NewSignatureSet(infos ...*SignatureInfo) (*SignatureSet, error) {
+	set := &SignatureSet{}
+	for _, info := range infos {
+		if err := set.Insert(info); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// Insert adds a copy of info to the set at its canonical position
+// Later changes to info do not affect the set
+//
+// Returns ErrDuplicateSignature if the set holds the same signature info and
+// ErrDuplicateSigner if it holds another signature by the same public key.
+func (set *SignatureSet) Insert(info *SignatureInfo) error {
+	if set == nil {
+		return errors.New("nil signature set")
+	}
+	if info == nil {
+		return errors.New("nil signature info")
+	}
+
+	commitment := info.Commitment()
+	i := sort.Search(len(set.entries), func(i int) bool {
+		return bytes.Compare(set.entries[i].commitment[:], commitment[:]) >= 0
+	})
+	if i < len(set.entries) && set.entries[i].commitment == commitment {
+		return ErrDuplicateSignature
+	}
+	for j := range set.entries {
+		if set.entries[j].info.PublicKey == info.PublicKey {
+			return ErrDuplicateSigner
+		}
+	}
+
+	set.entries = append(set.entries, setEntry{})
+	copy(set.entries[i+1:], set.entries[i:])
+	set.entries[i] = setEntry{commitment: commitment, info: *info}
+	return nil
+}
+
+// Len returns the number of signature infos in the set
+func (set *SignatureSet) Len() int {
+	return len(set.list())
+}
+
+// Entries returns copies of the signature infos in canonical order
+func (set *SignatureSet) Entries() []*SignatureInfo {
+	entries := set.list()
+	infos := make([]*SignatureInfo, len(entries))
+	for i := range entries {
+		info := entries[i].info
+		infos[i] = &info
+	}
+	return infos
+}
+
+// list returns the set's entries; a nil set reads as empty
+func (set *SignatureSet) list() []setEntry {
+	if set == nil {
+		return nil
+	}
+	return set.entries
+}
+
+// MarshalBinary encodes the set as a count prefix followed by the
+// concatenated 96-byte entries in canonical order
+func (set *SignatureSet) MarshalBinary() ([]byte, error) {
+	entries := set.list()
+	data := make([]byte, setCountSize, setCountSize+len(entries)*MAX_SIZE)
+	binary.BigEndian.PutUint32(data, uint32(len(entries)))
+	for i := range entries {
+		data = append(data, entries[i].info.ToBytes()...)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a set produced by MarshalBinary
+//
+// Only the canonical encoding is accepted: entries must be strictly ordered
+// by commitment hash, which also rules out duplicates, and no two entries may
+// share a public key.
+func (set *SignatureSet) UnmarshalBinary(data []byte) error {
+	if set == nil {
+		return errors.New("nil signature set")
+	}
+	if len(data) < setCountSize {
+		return fmt.Errorf("invalid signature set length: expected at least %d, got %d", setCountSize, len(data))
+	}
+
+	count := binary.BigEndian.Uint32(data)
+	body := data[setCountSize:]
+	if uint64(len(body)) != uint64(count)*MAX_SIZE {
+		return fmt.Errorf("invalid signature set length: %d entries require %d bytes, got %d", count, uint64(count)*MAX_SIZE, len(body))
+	}
+
+	entries := make([]setEntry, count)
+	signers := make(map[[32]byte]bool, count)
+	for i := range entries {
+		info, err := ParseSignatureInfo(body[i*MAX_SIZE : (i+1)*MAX_SIZE])
+		if err != nil {
+			return err
+		}
+		entries[i] = setEntry{commitment: info.Commitment(), info: *info}
+
+		if i > 0 && bytes.Compare(entries[i-1].commitment[:], entries[i].commitment[:]) >= 0 {
+			return ErrNonCanonicalSet
+		}
+		if signers[info.PublicKey] {
+			return ErrDuplicateSigner
+		}
+		signers[info.PublicKey] = true
+	}
+
+	set.entries = entries
+	return nil
+}
+
+// Hash returns keccak256 over the canonical encoding of the set
+func (set *SignatureSet) Hash() [32]byte {
+	data, _ := set.MarshalBinary()
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// VerifyMultisig verifies every signature in the set against the same payload hash
+//
+// Returns the derived addresses in canonical set order, or the first
+// verification error annotated with the failing entry's index. The set holds
+// one signature per public key, so the addresses are distinct and can be
+// counted against a threshold.
+func VerifyMultisig(set *SignatureSet, payloadHash [32]byte) ([]ExecutionAddress, error) {
+	if set == nil || len(set.entries) == 0 {
+		return nil, errors.New("empty signature set")
+	}
+
+	addresses := make([]ExecutionAddress, len(set.entries))
+	for i := range set.entries {
+		address, err := Verify(set.entries[i].info.ToBytes(), payloadHash)
+		if err != nil {
+			return nil, fmt.Errorf("signature %d: %w", i, err)
+		}
+		addresses[i] = address
+	}
+
+	return addresses, nil
+}
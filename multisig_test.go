@@ -0,0 +1,157 @@
+package eip7980
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"sort"
+	"testing"
+)
+
+// newTestSignatureSetInfos returns n signature infos over payloadHash
+func newTestSignatureSetInfos(t *testing.T, n int, payloadHash [32]byte) []*SignatureInfo {
+	t.Helper()
+
+	infos := make([]*SignatureInfo, n)
+	for i := range infos {
+		info, err := ParseSignatureInfo(newTestSignatureInfo(t, payloadHash))
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos[i] = info
+	}
+	return infos
+}
+
+// TestSignatureSetDeterministic tests that insertion order does not affect encoding or hash
+func TestSignatureSetDeterministic(t *testing.T) {
+	infos := newTestSignatureSetInfos(t, 3, [32]byte{})
+
+	forward, err := NewSignatureSet(infos[0], infos[1], infos[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	reverse, err := NewSignatureSet(infos[2], infos[1], infos[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	forwardBytes, _ := forward.MarshalBinary()
+	reverseBytes, _ := reverse.MarshalBinary()
+	if !bytes.Equal(forwardBytes, reverseBytes) {
+		t.Errorf("Encoding depends on insertion order")
+	}
+	if forward.Hash() != reverse.Hash() {
+		t.Errorf("Hash depends on insertion order")
+	}
+	if len(forwardBytes) != setCountSize+3*MAX_SIZE {
+		t.Errorf("Encoding length = %d, want %d", len(forwardBytes), setCountSize+3*MAX_SIZE)
+	}
+}
+
+// TestSignatureSetRoundTrip tests MarshalBinary/UnmarshalBinary symmetry
+func TestSignatureSetRoundTrip(t *testing.T) {
+	set, err := NewSignatureSet(newTestSignatureSetInfos(t, 4, [32]byte{})...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := set.MarshalBinary()
+
+	var decoded SignatureSet
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if decoded.Len() != set.Len() || decoded.Hash() != set.Hash() {
+		t.Errorf("Round trip changed the set")
+	}
+
+	// Swap the first two entries to break canonical ordering
+	swapped := append([]byte{}, data...)
+	copy(swapped[setCountSize:], data[setCountSize+MAX_SIZE:setCountSize+2*MAX_SIZE])
+	copy(swapped[setCountSize+MAX_SIZE:], data[setCountSize:setCountSize+MAX_SIZE])
+	if err := decoded.UnmarshalBinary(swapped); err != ErrNonCanonicalSet {
+		t.Errorf("Expected ErrNonCanonicalSet, got %v", err)
+	}
+
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Expected error for truncated encoding")
+	}
+}
+
+// TestSignatureSetDuplicate tests that duplicate entries are rejected
+func TestSignatureSetDuplicate(t *testing.T) {
+	infos := newTestSignatureSetInfos(t, 1, [32]byte{})
+
+	if _, err := NewSignatureSet(infos[0], infos[0]); err != ErrDuplicateSignature {
+		t.Errorf("Expected ErrDuplicateSignature, got %v", err)
+	}
+}
+
+// TestSignatureSetDuplicateSigner tests that one key cannot join a set twice with different nonces
+func TestSignatureSetDuplicateSigner(t *testing.T) {
+	payloadHash := [32]byte{0x03}
+	seed := bytes.Repeat([]byte{0x07}, ed25519.SeedSize)
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+
+	// The RFC 8032 nonce, and a different one: R = rB plus the identity point
+	identity := make([]byte, 32)
+	identity[0] = 0x01
+	first := NewSignatureInfoFromArrays([64]byte(ed25519.Sign(privateKey, payloadHash[:])), [32]byte(publicKey))
+	second := NewSignatureInfoFromArrays([64]byte(mixedOrderSignature(seed, publicKey, payloadHash[:], identity)), [32]byte(publicKey))
+	for _, info := range []*SignatureInfo{first, second} {
+		if _, err := Verify(info.ToBytes(), payloadHash); err != nil {
+			t.Fatalf("Expected both signatures to verify: %v", err)
+		}
+	}
+	if first.Commitment() == second.Commitment() {
+		t.Fatal("Expected distinct signatures")
+	}
+
+	other := newTestSignatureSetInfos(t, 1, payloadHash)[0]
+	if _, err := NewSignatureSet(first, other, second); !errors.Is(err, ErrDuplicateSigner) {
+		t.Errorf("Expected ErrDuplicateSigner from Insert, got %v", err)
+	}
+
+	// A hand-built canonical encoding must not smuggle the second signature in
+	entries := []*SignatureInfo{first, second}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].Commitment(), entries[j].Commitment()
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+	data := []byte{0, 0, 0, 2}
+	for _, info := range entries {
+		data = append(data, info.ToBytes()...)
+	}
+	var decoded SignatureSet
+	if err := decoded.UnmarshalBinary(data); !errors.Is(err, ErrDuplicateSigner) {
+		t.Errorf("Expected ErrDuplicateSigner from UnmarshalBinary, got %v", err)
+	}
+}
+
+// TestVerifyMultisig tests verification of every signer in a set
+func TestVerifyMultisig(t *testing.T) {
+	payloadHash := [32]byte{0x01}
+	infos := newTestSignatureSetInfos(t, 3, payloadHash)
+
+	set, err := NewSignatureSet(infos...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addresses, err := VerifyMultisig(set, payloadHash)
+	if err != nil {
+		t.Fatalf("VerifyMultisig failed: %v", err)
+	}
+	if len(addresses) != 3 {
+		t.Errorf("Got %d addresses, want 3", len(addresses))
+	}
+
+	if _, err := VerifyMultisig(set, [32]byte{0x02}); err == nil {
+		t.Errorf("Expected error for wrong payload hash")
+	}
+	if _, err := VerifyMultisig(&SignatureSet{}, payloadHash); err == nil {
+		t.Errorf("Expected error for empty set")
+	}
+}
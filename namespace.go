@@ -0,0 +1,99 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// NAMESPACE_PREFIX starts every namespaced payload preimage
+const NAMESPACE_PREFIX = "eip7980/ns/"
+
+// MAX_NAMESPACE_LENGTH is the longest namespace name RegisterNamespace accepts, in bytes
+const MAX_NAMESPACE_LENGTH = 64
+
+// Namespace registration failures
+var (
+	ErrNamespaceRegistered = errors.New("namespace already registered")
+	ErrNamespaceName       = errors.New("invalid namespace name")
+)
+
+// errZeroNamespace is returned when signing or verifying with a Namespace not obtained from RegisterNamespace
+var errZeroNamespace = errors.New("namespace is not registered")
+
+// namespaces holds every name registered in this process
+var namespaces = struct {
+	sync.Mutex
+	names map[string]struct{}
+}{names: map[string]struct{}{}}
+
+// Namespace domain-separates off-chain application signatures
+//
+// Payloads are hashed as keccak256("eip7980/ns/" || name || 0x00 || payload).
+// The prefix keeps namespaced hashes apart from transaction payload hashes,
+// and the 0x00 separator, which names cannot contain, keeps one namespace's
+// payloads from being read as another's. Obtain one with RegisterNamespace.
+type Namespace struct {
+	name string
+}
+
+// RegisterNamespace claims name for this process and returns its Namespace
+//
+// Names must be 1 to MAX_NAMESPACE_LENGTH bytes without NUL bytes; a name
+// that is already registered fails with ErrNamespaceRegistered. Uniqueness is
+// per process, so applications should still pick names unlikely to collide,
+// such as reverse-DNS identifiers.
+func RegisterNamespace(name string) (Namespace, error) {
+	if name == "" || len(name) > MAX_NAMESPACE_LENGTH {
+		return Namespace{}, fmt.Errorf("%w: length must be 1 to %d bytes, got %d", ErrNamespaceName, MAX_NAMESPACE_LENGTH, len(name))
+	}
+	if strings.IndexByte(name, 0) >= 0 {
+		return Namespace{}, fmt.Errorf("%w: contains a NUL byte", ErrNamespaceName)
+	}
+
+	namespaces.Lock()
+	defer namespaces.Unlock()
+	if _, ok := namespaces.names[name]; ok {
+		return Namespace{}, fmt.Errorf("%w: %q", ErrNamespaceRegistered, name)
+	}
+	namespaces.names[name] = struct{}{}
+	return Namespace{name: name}, nil
+}
+
+// Name returns the registered name, or "" for the zero Namespace
+func (ns Namespace) Name() string {
+	return ns.name
+}
+
+// PayloadHash returns keccak256("eip7980/ns/" || name || 0x00 || payload)
+func (ns Namespace) PayloadHash(payload []byte) [32]byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(NAMESPACE_PREFIX))
+	hash.Write([]byte(ns.name))
+	hash.Write([]byte{0x00})
+	hash.Write(payload)
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// Sign signs PayloadHash(payload) with Sign and returns the 96-byte signature_info
+func (ns Namespace) Sign(privateKey ed25519.PrivateKey, payload []byte) ([]byte, error) {
+	if ns.name == "" {
+		return nil, errZeroNamespace
+	}
+	return Sign(privateKey, ns.PayloadHash(payload))
+}
+
+// Verify verifies a signature made by Sign in the same namespace
+func (ns Namespace) Verify(signatureInfo []byte, payload []byte) (ExecutionAddress, error) {
+	if ns.name == "" {
+		return ExecutionAddress{}, errZeroNamespace
+	}
+	return Verify(signatureInfo, ns.PayloadHash(payload))
+}
@@ -0,0 +1,82 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// registerTestNamespace registers name for the duration of the test
+func registerTestNamespace(t *testing.T, name string) Namespace {
+	t.Helper()
+	ns, err := RegisterNamespace(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		namespaces.Lock()
+		delete(namespaces.names, name)
+		namespaces.Unlock()
+	})
+	return ns
+}
+
+// TestNamespaceCrossRejection tests that signatures only verify in the namespace that made them
+func TestNamespaceCrossRejection(t *testing.T) {
+	privateKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	payload := []byte("login:alice")
+
+	app := registerTestNamespace(t, "org.example.app")
+	other := registerTestNamespace(t, "org.example.other")
+	// A name extending another must still be separate
+	short := registerTestNamespace(t, "org.example.app2")
+
+	signatureInfo, err := app.Sign(privateKey, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address, err := app.Verify(signatureInfo, payload); err != nil || address != KnownAnswerTests[0].Address {
+		t.Errorf("Expected %s, got %s, %v", KnownAnswerTests[0].Address, address, err)
+	}
+
+	for _, ns := range []Namespace{other, short} {
+		if _, err := ns.Verify(signatureInfo, payload); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: expected ErrInvalidSignature, got %v", ns.Name(), err)
+		}
+	}
+	if _, err := app.Verify(signatureInfo, []byte("login:bob")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Different payload: expected ErrInvalidSignature, got %v", err)
+	}
+
+	// Neither the raw payload hash nor the plain string hash matches
+	if _, err := VerifyStringPayload(signatureInfo, string(payload)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Transaction-style hash: expected ErrInvalidSignature, got %v", err)
+	}
+	if want := StringPayloadHash("eip7980/ns/org.example.app\x00login:alice"); app.PayloadHash(payload) != want {
+		t.Errorf("Expected keccak256(prefix || name || 0x00 || payload)")
+	}
+
+	var zero Namespace
+	if _, err := zero.Sign(privateKey, payload); err == nil {
+		t.Errorf("Expected error signing with the zero Namespace")
+	}
+	if _, err := zero.Verify(signatureInfo, payload); err == nil {
+		t.Errorf("Expected error verifying with the zero Namespace")
+	}
+}
+
+// TestRegisterNamespaceRejects tests duplicate registration and the name rules
+func TestRegisterNamespaceRejects(t *testing.T) {
+	registerTestNamespace(t, "org.example.dup")
+	if _, err := RegisterNamespace("org.example.dup"); !errors.Is(err, ErrNamespaceRegistered) {
+		t.Errorf("Expected ErrNamespaceRegistered, got %v", err)
+	}
+
+	for _, name := range []string{"", strings.Repeat("n", MAX_NAMESPACE_LENGTH+1), "bad\x00name"} {
+		if _, err := RegisterNamespace(name); !errors.Is(err, ErrNamespaceName) {
+			t.Errorf("Name %q: expected ErrNamespaceName, got %v", name, err)
+		}
+	}
+	registerTestNamespace(t, strings.Repeat("n", MAX_NAMESPACE_LENGTH))
+}
@@ -0,0 +1,316 @@
+package eip7980
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+// nilSweep calls every exported function and method with nil or zero-valued
+// arguments. Methods with pointer receivers are called on both a nil pointer
+// and a pointer to the zero value. New exported API must be added here;
+// TestNilSweepComplete fails until it is.
+var nilSweep = map[string]func(){
+	"AssertKeyAddress":              func() { AssertKeyAddress(nil, ExecutionAddress{}) },
+	"CalibrationRun":                func() { CalibrationRun(CalibrationConfig{}) },
+	"CanonicalAminoJSON":            func() { CanonicalAminoJSON(nil) },
+	"DefaultReservedAddresses":      func() { DefaultReservedAddresses() },
+	"DeriveAddress":                 func() { DeriveAddress(nil) },
+	"DeriveAddressNetworked":        func() { DeriveAddressNetworked(nil, 0) },
+	"DeriveAddressMap":              func() { DeriveAddressMap(nil); DeriveAddressMap([][]byte{nil}) },
+	"DiagnoseEndianness":            func() { DiagnoseEndianness(nil, [32]byte{}) },
+	"DiffSignatureInfo":             func() { DiffSignatureInfo(nil, nil) },
+	"DomainPayloadHash":             func() { DomainPayloadHash([32]byte{}, [32]byte{}) },
+	"VerifySplit":                   func() { VerifySplit(nil, nil, [32]byte{}) },
+	"VerifyWithDomain":              func() { VerifyWithDomain(nil, [32]byte{}, [32]byte{}) },
+	"ExportBundle":                  func() { ExportBundle(nil, ""); ExportBundle([]LabeledKey{{}}, "") },
+	"ImportBundle":                  func() { ImportBundle(nil, "") },
+	"MultiHashPayload":              func() { MultiHashPayload() },
+	"NewAddressBook":                func() { NewAddressBook() },
+	"NewLabeledKey":                 func() { NewLabeledKey("", nil) },
+	"NewSignatureInfoFromArrays":    func() { NewSignatureInfoFromArrays([64]byte{}, [32]byte{}) },
+	"NewSignatureSet":               func() { NewSignatureSet(); NewSignatureSet(nil) },
+	"NewVerifier":                   func() { NewVerifier() },
+	"NewVerifyCache":                func() { NewVerifyCache() },
+	"PackBatch":                     func() { PackBatch(nil); PackBatch([]*SignatureInfo{nil}) },
+	"UnpackBatch":                   func() { UnpackBatch(nil) },
+	"ParseAddress":                  func() { ParseAddress("", false); ParseAddress("", true) },
+	"ParseHash32":                   func() { ParseHash32("") },
+	"ParseSignatureInfo":            func() { ParseSignatureInfo(nil) },
+	"ParseSignatureInfoListLenient": func() { ParseSignatureInfoListLenient(nil) },
+	"PublicKeyFingerprint":          func() { PublicKeyFingerprint(nil) },
+	"RunBench":                      func() { RunBench(BenchConfig{}) },
+	"RunKATs":                       func() { RunKATs(nil) },
+	"SignString":                    func() { SignString(nil, "") },
+	"StringPayloadHash":             func() { StringPayloadHash("") },
+	"VerifyStringPayload":           func() { VerifyStringPayload(nil, "") },
+	"SpecDescriptor":                func() { SpecDescriptor() },
+	"TimedPayloadHash":              func() { TimedPayloadHash(0, [32]byte{}) },
+	"Verify":                        func() { Verify(nil, [32]byte{}) },
+	"VerifyADR036":                  func() { VerifyADR036(nil, [64]byte{}, [32]byte{}) },
+	"NewResult":                     func() { NewResult(nil, [32]byte{}, nil) },
+	"ExpiryPayloadHash":             func() { ExpiryPayloadHash(time.Time{}, nil) },
+	"SignWithExpiry":                func() { SignWithExpiry(nil, nil, time.Time{}) },
+	"VerifyExpiring": func() {
+		VerifyExpiring(nil, nil, 0, time.Time{})
+		VerifyExpiring(&ExpiringSignature{}, nil, 0, time.Time{})
+	},
+	"ParseSignatureInfoInto":         func() { ParseSignatureInfoInto(nil, nil); ParseSignatureInfoInto(new(SignatureInfo), nil) },
+	"SameSigner":                     func() { SameSigner(nil, nil) },
+	"DeterministicVectors":           func() { DeterministicVectors(0); DeterministicVectors(-1) },
+	"ExportFixtures":                 func() { ExportFixtures(nil, LangJSON, nil); ExportFixtures(io.Discard, LangSolidity, []KAT{{}}) },
+	"ParseLang":                      func() { ParseLang("") },
+	"EpochPayloadHash":               func() { EpochPayloadHash([32]byte{}, 0) },
+	"VerifyEpoch":                    func() { VerifyEpoch(nil, [32]byte{}, 0) },
+	"NewExternalHash":                func() { NewExternalHash([32]byte{}, "") },
+	"NewKeccakHash":                  func() { NewKeccakHash(nil) },
+	"SignWithProvenance":             func() { SignWithProvenance(nil, PayloadHashProvenance{}) },
+	"VerifyWithProvenance":           func() { VerifyWithProvenance(nil, PayloadHashProvenance{}) },
+	"Explain":                        func() { Explain(nil, [32]byte{}) },
+	"FailureReport":                  func() { FailureReport(nil, [32]byte{}) },
+	"GenerateKeys":                   func() { GenerateKeys(0, nil); GenerateKeys(-1, nil) },
+	"WriteManifest":                  func() { WriteManifest(nil, nil, nil); WriteManifest(io.Discard, []LabeledKey{{}}, nil) },
+	"Sign":                           func() { Sign(nil, [32]byte{}) },
+	"CompareBackends":                func() { CompareBackends(BenchConfig{}) },
+	"VerifyTimedResult":              func() { VerifyTimedResult(nil, [32]byte{}) },
+	"CanonicalJSON":                  func() { CanonicalJSON(nil) },
+	"JSONPayloadHash":                func() { JSONPayloadHash(nil) },
+	"SignJSON":                       func() { SignJSON(nil, nil) },
+	"VerifyJSONPayload":              func() { VerifyJSONPayload(nil, nil) },
+	"Frame":                          func() { Frame(nil) },
+	"Unframe":                        func() { Unframe(nil) },
+	"RegisterNamespace":              func() { RegisterNamespace("") },
+	"Namespace.Name":                 func() { Namespace{}.Name() },
+	"Namespace.PayloadHash":          func() { Namespace{}.PayloadHash(nil) },
+	"Namespace.Sign":                 func() { Namespace{}.Sign(nil, nil); Namespace{name: "x"}.Sign(nil, nil) },
+	"Namespace.Verify":               func() { Namespace{}.Verify(nil, nil); Namespace{name: "x"}.Verify(nil, nil) },
+	"GasCost":                        func() { GasCost(nil) },
+	"VerifyJSON":                     func() { VerifyJSON(nil, [32]byte{}) },
+	"AssembleSignatureInfo":          func() { AssembleSignatureInfo(nil, nil, nil) },
+	"SignatureInfo.R":                func() { (*SignatureInfo)(nil).R() },
+	"SignatureInfo.S":                func() { (*SignatureInfo)(nil).S() },
+	"SignatureInfo.DecodedR":         func() { (*SignatureInfo)(nil).DecodedR(); new(SignatureInfo).DecodedR() },
+	"SignatureInfo.DecodedS":         func() { (*SignatureInfo)(nil).DecodedS(); new(SignatureInfo).DecodedS() },
+	"SignatureInfo.DecodedPublicKey": func() { (*SignatureInfo)(nil).DecodedPublicKey(); new(SignatureInfo).DecodedPublicKey() },
+	"RegisterBackend":                func() { RegisterBackend("", nil) },
+	"WriteBenchstat":                 func() { WriteBenchstat(nil, nil); WriteBenchstat(io.Discard, nil) },
+	"SetBackend":                     func() { SetBackend(nil) },
+	"StdlibBackend.Verify":           func() { StdlibBackend{}.Verify(nil, nil, nil) },
+	"VerifyFromTuple":                func() { VerifyFromTuple(nil, [32]byte{}); VerifyFromTuple([][]byte{nil, nil}, [32]byte{}) },
+	"ParseICAP":                      func() { ParseICAP("") },
+	"WithKeyFunc":                    func() { NewVerifyCache(WithKeyFunc(nil)).Close() },
+	"WithNegativeCaching":            func() { NewVerifyCache(WithNegativeCaching()).Close() },
+	"WithMaxEntries":                 func() { NewVerifyCache(WithMaxEntries(-1)).Close() },
+	"WithTTL":                        func() { NewVerifyCache(WithTTL(-1)).Close() },
+	"VerifyAllForms":                 func() { VerifyAllForms(nil, [32]byte{}) },
+	"VerifyBatch":                    func() { VerifyBatch(nil); VerifyBatch([]BatchItem{{}}) },
+	"VerifyBatchAgainstAddresses": func() {
+		VerifyBatchAgainstAddresses(nil, nil, nil)
+		VerifyBatchAgainstAddresses([][]byte{nil}, [][32]byte{{}}, []ExecutionAddress{{}})
+	},
+	"VerifyFull":          func() { VerifyFull(nil, [32]byte{}) },
+	"VerifyKeyOnly":       func() { VerifyKeyOnly(nil, [32]byte{}) },
+	"VerifyMultiHash":     func() { VerifyMultiHash(nil) },
+	"VerifyMultisig":      func() { VerifyMultisig(nil, [32]byte{}); VerifyMultisig(&SignatureSet{}, [32]byte{}) },
+	"VerifyProfiled":      func() { VerifyProfiled(nil, [32]byte{}) },
+	"VerifySolanaMessage": func() { VerifySolanaMessage([64]byte{}, [32]byte{}, nil) },
+	"ValidatePublicKey":   func() { ValidatePublicKey(nil) },
+	"VerifyStrict":        func() { VerifyStrict(nil, [32]byte{}); VerifyStrict(make([]byte, MAX_SIZE), [32]byte{}) },
+	"VerifyTimed":         func() { VerifyTimed(nil, [32]byte{}, 0, time.Time{}) },
+
+	"AddressBook.Address": func() { forBook(func(b *AddressBook) { b.Address("") }) },
+	"AddressBook.Label":   func() { forBook(func(b *AddressBook) { b.Label(ExecutionAddress{}) }) },
+	"AddressBook.Len":     func() { forBook(func(b *AddressBook) { b.Len() }) },
+	"AddressBook.ReadCSV": func() { forBook(func(b *AddressBook) { b.ReadCSV(nil, false) }) },
+	"AddressBook.ReadJSON": func() {
+		forBook(func(b *AddressBook) { b.ReadJSON(nil, false) })
+	},
+	"AddressBook.Set": func() {
+		forBook(func(b *AddressBook) { b.Set("", ExecutionAddress{}); b.Set("a", ExecutionAddress{}) })
+	},
+	"AddressBook.SetForce":  func() { forBook(func(b *AddressBook) { b.SetForce("a", ExecutionAddress{}) }) },
+	"AddressBook.WriteCSV":  func() { forBook(func(b *AddressBook) { b.WriteCSV(nil) }) },
+	"AddressBook.WriteJSON": func() { forBook(func(b *AddressBook) { b.WriteJSON(nil) }) },
+
+	"BatchError.Error": func() { _ = BatchError(nil).Error(); _ = BatchError{nil}.Error() },
+
+	"DiffReport.Equal":  func() { DiffReport{}.Equal() },
+	"DiffReport.String": func() { _ = DiffReport{}.String() },
+
+	"ExecutionAddress.ChecksumString": func() { ExecutionAddress{}.ChecksumString() },
+	"Result.Render":                   func() { Result{}.Render(nil, ""); Result{}.Render(io.Discard, "html") },
+	"ExpiringSignature.MarshalJSON": func() {
+		(*ExpiringSignature)(nil).MarshalJSON()
+		new(ExpiringSignature).MarshalJSON()
+	},
+	"ExpiringSignature.UnmarshalJSON": func() {
+		(*ExpiringSignature)(nil).UnmarshalJSON(nil)
+		new(ExpiringSignature).UnmarshalJSON(nil)
+	},
+	"ExpiringSignature.MarshalBinary": func() {
+		(*ExpiringSignature)(nil).MarshalBinary()
+		new(ExpiringSignature).MarshalBinary()
+	},
+	"ExpiringSignature.UnmarshalBinary": func() {
+		(*ExpiringSignature)(nil).UnmarshalBinary(nil)
+		new(ExpiringSignature).UnmarshalBinary(nil)
+	},
+	"ExecutionAddress.Equal":       func() { _ = ExecutionAddress{}.Equal(ExecutionAddress{}) },
+	"Arena.Get":                    func() { (*Arena)(nil).Get(); new(Arena).Get() },
+	"Arena.Len":                    func() { (*Arena)(nil).Len(); new(Arena).Len() },
+	"Arena.Parse":                  func() { (*Arena)(nil).Parse(nil); new(Arena).Parse(make([]byte, MAX_SIZE)) },
+	"Arena.Reset":                  func() { (*Arena)(nil).Reset(); new(Arena).Reset() },
+	"ItemLabel.String":             func() { _ = ItemLabel(0).String() },
+	"NewGenerator":                 func() { NewGenerator(GeneratorConfig{}) },
+	"Generator.Next":               func() { (*Generator)(nil).Next(); new(Generator).Next() },
+	"Generator.Items":              func() { (*Generator)(nil).Items(1); new(Generator).Items(-1) },
+	"Lang.String":                  func() { _ = Lang(0).String() },
+	"PayloadHashProvenance.String": func() { _ = PayloadHashProvenance{}.String() },
+	"Explanation.Err":              func() { _ = Explanation{}.Err() },
+	"SignOptions.Sign":             func() { SignOptions{}.Sign(nil, [32]byte{}) },
+	"ExecutionAddress.Format":      func() { _ = fmt.Sprintf("%q %s %d", ExecutionAddress{}, ExecutionAddress{}, ExecutionAddress{}) },
+	"VerifyCache.Close":            func() { (*VerifyCache)(nil).Close(); new(VerifyCache).Close() },
+	"ExecutionAddress.ICAP":        func() { _ = ExecutionAddress{}.ICAP() },
+	"ExecutionAddress.MarshalText": func() { ExecutionAddress{}.MarshalText() },
+	"ExecutionAddress.String":      func() { _ = ExecutionAddress{}.String() },
+	"ExecutionAddress.UnmarshalText": func() {
+		(*ExecutionAddress)(nil).UnmarshalText(nil)
+		new(ExecutionAddress).UnmarshalText(nil)
+	},
+
+	"Hash32.MarshalText":   func() { Hash32{}.MarshalText() },
+	"Hash32.String":        func() { _ = Hash32{}.String() },
+	"Hash32.UnmarshalText": func() { (*Hash32)(nil).UnmarshalText(nil); new(Hash32).UnmarshalText(nil) },
+
+	"Histogram.WriteCSV": func() { Histogram{}.WriteCSV(nil) },
+
+	"IndexedError.Error":    func() { _ = (*IndexedError)(nil).Error(); _ = new(IndexedError).Error() },
+	"IndexedError.LogValue": func() { (*IndexedError)(nil).LogValue(); new(IndexedError).LogValue() },
+	"IndexedError.Unwrap":   func() { (*IndexedError)(nil).Unwrap(); new(IndexedError).Unwrap() },
+
+	"KAT.SignatureInfo": func() { KAT{}.SignatureInfo() },
+	"KAT.MarshalJSON":   func() { KAT{}.MarshalJSON() },
+
+	"ProfileStats.Share": func() { ProfileStats{}.Share(0) },
+	"ProfileStats.Total": func() { ProfileStats{}.Total() },
+
+	"SignatureInfo.Clone": func() { (*SignatureInfo)(nil).Clone(); new(SignatureInfo).Clone() },
+	"SignatureInfo.MatchesAddress": func() {
+		(*SignatureInfo)(nil).MatchesAddress(ExecutionAddress{})
+		new(SignatureInfo).MatchesAddress(ExecutionAddress{})
+	},
+	"SignatureInfo.Commitment": func() { (*SignatureInfo)(nil).Commitment(); new(SignatureInfo).Commitment() },
+	"SignatureInfo.ToBytes":    func() { (*SignatureInfo)(nil).ToBytes(); new(SignatureInfo).ToBytes() },
+
+	"SignatureSet.Entries":       func() { forSet(func(s *SignatureSet) { s.Entries() }) },
+	"SignatureSet.Hash":          func() { forSet(func(s *SignatureSet) { s.Hash() }) },
+	"SignatureSet.Insert":        func() { forSet(func(s *SignatureSet) { s.Insert(nil); s.Insert(&SignatureInfo{}) }) },
+	"SignatureSet.Len":           func() { forSet(func(s *SignatureSet) { s.Len() }) },
+	"SignatureSet.MarshalBinary": func() { forSet(func(s *SignatureSet) { s.MarshalBinary() }) },
+	"SignatureSet.UnmarshalBinary": func() {
+		forSet(func(s *SignatureSet) { s.UnmarshalBinary(nil) })
+	},
+
+	"Verifier.Verify": func() { forVerifier(func(v *Verifier) { v.Verify(nil, [32]byte{}) }) },
+	"Verifier.VerifyContext": func() {
+		forVerifier(func(v *Verifier) { v.VerifyContext(nil, nil, [32]byte{}) })
+	},
+
+	"Verifier.ClassifyAddress": func() {
+		forVerifier(func(v *Verifier) { v.ClassifyAddress(ExecutionAddress{}) })
+	},
+
+	"AddressClass.String": func() { _ = AddressClass(0).String(); _ = AddressClass(-1).String() },
+
+	"VerifyCache.InvalidateFrom": func() { forCache(func(c *VerifyCache) { c.InvalidateFrom(0) }) },
+	"VerifyCache.Len":            func() { forCache(func(c *VerifyCache) { c.Len() }) },
+	"VerifyCache.VerifyAt": func() {
+		forCache(func(c *VerifyCache) {
+			c.VerifyAt(0, nil, [32]byte{})
+			c.VerifyAt(0, make([]byte, MAX_SIZE), [32]byte{})
+		})
+	},
+}
+
+func forBook(call func(*AddressBook))  { call(nil); call(new(AddressBook)) }
+func forSet(call func(*SignatureSet))  { call(nil); call(new(SignatureSet)) }
+func forVerifier(call func(*Verifier)) { call(nil); call(new(Verifier)) }
+func forCache(call func(*VerifyCache)) { call(nil); call(new(VerifyCache)) }
+
+// TestNilSweep tests that no exported API panics on nil or zero-valued input
+func TestNilSweep(t *testing.T) {
+	useFastBundleKDF(t)
+
+	for name, call := range nilSweep {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%s panicked: %v", name, r)
+				}
+			}()
+			call()
+		}()
+	}
+}
+
+// TestNilSweepComplete tests that nilSweep covers every exported function and method
+func TestNilSweepComplete(t *testing.T) {
+	pkg, err := build.Default.ImportDir(".", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, name), nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+			if fn.Recv == nil {
+				exported[fn.Name.Name] = true
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok && ident.IsExported() {
+				exported[ident.Name+"."+fn.Name.Name] = true
+			}
+		}
+	}
+
+	var missing, stale []string
+	for name := range exported {
+		if nilSweep[name] == nil {
+			missing = append(missing, name)
+		}
+	}
+	for name := range nilSweep {
+		if !exported[name] {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+
+	if len(missing) > 0 {
+		t.Errorf("Exported API missing from nilSweep: %v", missing)
+	}
+	if len(stale) > 0 {
+		t.Errorf("nilSweep entries with no exported API: %v", stale)
+	}
+}
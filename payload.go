@@ -0,0 +1,116 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// MultiHashPayload combines component hashes into a single payload hash:
+// keccak256(hashes[0] || hashes[1] || ... || hashes[n-1]), in argument order
+func MultiHashPayload(hashes ...[32]byte) [32]byte {
+	hash := sha3.NewLegacyKeccak256()
+	for i := range hashes {
+		hash.Write(hashes[i][:])
+	}
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// VerifyMultiHash verifies a signature over a composite payload made of several component hashes
+//
+// The signed payload hash is MultiHashPayload(hashes...): the 32-byte
+// components are concatenated in the order given, without length prefixes or
+// separators, and hashed with keccak256. Reordering the components changes the
+// payload. At least one component is required.
+func VerifyMultiHash(signatureInfo []byte, hashes ...[32]byte) (ExecutionAddress, error) {
+	if len(hashes) == 0 {
+		return ExecutionAddress{}, errors.New("no component hashes supplied")
+	}
+
+	return Verify(signatureInfo, MultiHashPayload(hashes...))
+}
+
+// DOMAIN_PAYLOAD_TAG prefixes every domain-scoped digest so it cannot collide with MultiHashPayload
+const DOMAIN_PAYLOAD_TAG = "EIP-7980 domain payload v1"
+
+// DomainPayloadHash scopes payloadHash to an application domain:
+// keccak256(DOMAIN_PAYLOAD_TAG || domain || payloadHash)
+//
+// Without the tag the preimage would be the same 64 bytes MultiHashPayload
+// hashes for the components domain and payloadHash, so a signature made for
+// one scheme would verify under the other.
+func DomainPayloadHash(domain [32]byte, payloadHash [32]byte) [32]byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(DOMAIN_PAYLOAD_TAG))
+	hash.Write(domain[:])
+	hash.Write(payloadHash[:])
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// VerifyWithDomain verifies a signature over DomainPayloadHash(domain, payloadHash)
+//
+// Signers must sign the mixed hash, not payloadHash itself. This gives
+// app-level domain separation without changing the core spec: a signature
+// made for one domain does not verify under any other.
+func VerifyWithDomain(signatureInfo []byte, payloadHash [32]byte, domain [32]byte) (ExecutionAddress, error) {
+	return Verify(signatureInfo, DomainPayloadHash(domain, payloadHash))
+}
+
+// EpochPayloadHash binds an epoch to baseHash: keccak256(baseHash || epoch),
+// with epoch as 8-byte big-endian
+func EpochPayloadHash(baseHash [32]byte, epoch uint64) [32]byte {
+	var suffix [8]byte
+	binary.BigEndian.PutUint64(suffix[:], epoch)
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(baseHash[:])
+	hash.Write(suffix[:])
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// VerifyEpoch verifies a signature over EpochPayloadHash(baseHash, epoch)
+//
+// It supports key-rotation schemes where the signer uses a different key
+// per epoch and signs the epoch number along with the payload. A signature
+// made for one epoch does not verify under any other. Mapping the returned
+// address to the key expected for epoch is left to the caller.
+func VerifyEpoch(signatureInfo []byte, baseHash [32]byte, epoch uint64) (ExecutionAddress, error) {
+	return Verify(signatureInfo, EpochPayloadHash(baseHash, epoch))
+}
+
+// StringPayloadHash returns keccak256 of the UTF-8 bytes of payload
+func StringPayloadHash(payload string) [32]byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(payload))
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// VerifyStringPayload verifies a signature over StringPayloadHash(payload)
+//
+// This is a convenience for experiments and REPL use. On-chain verification
+// always operates on a prehashed 32-byte payload; use Verify there.
+func VerifyStringPayload(signatureInfo []byte, payload string) (ExecutionAddress, error) {
+	return Verify(signatureInfo, StringPayloadHash(payload))
+}
+
+// SignString signs StringPayloadHash(payload) and returns the 96-byte signature_info
+//
+// It is the counterpart of VerifyStringPayload, meant for the same
+// experimental use.
+func SignString(privateKey ed25519.PrivateKey, payload string) ([]byte, error) {
+	return Sign(privateKey, StringPayloadHash(payload))
+}
@@ -0,0 +1,118 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+// TestVerifyMultiHash tests composite payloads of two and three component hashes
+func TestVerifyMultiHash(t *testing.T) {
+	a, b, c := [32]byte{0x0a}, [32]byte{0x0b}, [32]byte{0x0c}
+
+	for _, components := range [][][32]byte{{a, b}, {a, b, c}} {
+		signatureInfo := newTestSignatureInfo(t, MultiHashPayload(components...))
+
+		if _, err := VerifyMultiHash(signatureInfo, components...); err != nil {
+			t.Errorf("%d components: VerifyMultiHash failed: %v", len(components), err)
+		}
+
+		// Swapping the first two components changes the payload
+		swapped := append([][32]byte{components[1], components[0]}, components[2:]...)
+		if _, err := VerifyMultiHash(signatureInfo, swapped...); err != ErrInvalidSignature {
+			t.Errorf("%d components: expected ErrInvalidSignature for reordered hashes, got %v", len(components), err)
+		}
+	}
+
+	if _, err := VerifyMultiHash(newTestSignatureInfo(t, a)); err == nil {
+		t.Errorf("Expected error for no component hashes")
+	}
+}
+
+// TestVerifyWithDomain tests that a signature for domain A fails for domain B
+func TestVerifyWithDomain(t *testing.T) {
+	payloadHash := [32]byte{0x11}
+	domainA := [32]byte{'a'}
+	domainB := [32]byte{'b'}
+
+	signatureInfo := newTestSignatureInfo(t, DomainPayloadHash(domainA, payloadHash))
+
+	if _, err := VerifyWithDomain(signatureInfo, payloadHash, domainA); err != nil {
+		t.Errorf("Domain A rejected: %v", err)
+	}
+	if _, err := VerifyWithDomain(signatureInfo, payloadHash, domainB); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Domain B: expected ErrInvalidSignature, got %v", err)
+	}
+	if _, err := Verify(signatureInfo, payloadHash); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Unscoped hash: expected ErrInvalidSignature, got %v", err)
+	}
+
+}
+
+// TestDomainPayloadHashSeparated tests that domain and multi-hash signatures do not verify as each other
+func TestDomainPayloadHashSeparated(t *testing.T) {
+	payloadHash := [32]byte{0x11}
+	domain := [32]byte{'a'}
+
+	domainSignature := newTestSignatureInfo(t, DomainPayloadHash(domain, payloadHash))
+	if _, err := VerifyMultiHash(domainSignature, domain, payloadHash); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Domain signature as multi-hash: expected ErrInvalidSignature, got %v", err)
+	}
+
+	multiHashSignature := newTestSignatureInfo(t, MultiHashPayload(domain, payloadHash))
+	if _, err := VerifyWithDomain(multiHashSignature, payloadHash, domain); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Multi-hash signature under a domain: expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+// TestVerifyEpoch tests that a signature only verifies for the epoch it binds
+func TestVerifyEpoch(t *testing.T) {
+	baseHash := [32]byte{0x22}
+	const epoch = 7
+
+	signatureInfo := newTestSignatureInfo(t, EpochPayloadHash(baseHash, epoch))
+	if _, err := VerifyEpoch(signatureInfo, baseHash, epoch); err != nil {
+		t.Errorf("Epoch %d rejected: %v", epoch, err)
+	}
+	for _, other := range []uint64{0, epoch - 1, epoch + 1, epoch << 56} {
+		if _, err := VerifyEpoch(signatureInfo, baseHash, other); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Epoch %d: expected ErrInvalidSignature, got %v", other, err)
+		}
+	}
+
+	// The epoch is appended as 8-byte big-endian
+	want := StringPayloadHash(string(baseHash[:]) + "\x00\x00\x00\x00\x00\x00\x00\x07")
+	if got := EpochPayloadHash(baseHash, epoch); got != want {
+		t.Errorf("Expected keccak256(baseHash || uint64be(epoch)) = %x, got %x", want, got)
+	}
+}
+
+// TestSignStringRoundTrip tests string payload signing and verification
+func TestSignStringRoundTrip(t *testing.T) {
+	privateKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+
+	signatureInfo, err := SignString(privateKey, "héllo, eip-7980")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := VerifyStringPayload(signatureInfo, "héllo, eip-7980")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != KnownAnswerTests[0].Address {
+		t.Errorf("Expected %s, got %s", KnownAnswerTests[0].Address, address)
+	}
+
+	// The convenience path must agree with Verify on the prehashed value
+	if _, err := Verify(signatureInfo, StringPayloadHash("héllo, eip-7980")); err != nil {
+		t.Errorf("Verify on the prehashed payload failed: %v", err)
+	}
+	if _, err := VerifyStringPayload(signatureInfo, "hello, eip-7980"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature for a different string, got %v", err)
+	}
+
+	if _, err := SignString(privateKey[:10], "x"); err == nil {
+		t.Errorf("Expected error for a short private key")
+	}
+}
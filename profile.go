@@ -0,0 +1,77 @@
+package eip7980
+
+import (
+	"time"
+)
+
+// ProfileStats records the time VerifyProfiled spent in each verification stage
+//
+// Stages that were not reached because an earlier one failed stay zero.
+type ProfileStats struct {
+	LengthCheck       time.Duration
+	KeyValidation     time.Duration
+	SignatureVerify   time.Duration
+	AddressDerivation time.Duration
+}
+
+// Total returns the summed time of all stages
+func (p ProfileStats) Total() time.Duration {
+	return p.LengthCheck + p.KeyValidation + p.SignatureVerify + p.AddressDerivation
+}
+
+// Share returns the fraction of Total spent in stage, or 0 when nothing was timed
+func (p ProfileStats) Share(stage time.Duration) float64 {
+	total := p.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(stage) / float64(total)
+}
+
+// VerifyProfiled performs the same checks as Verify and times each stage
+//
+// This is a diagnostic tool for optimization work; the clock reads make it
+// noticeably slower than Verify, so keep it off hot paths.
+func VerifyProfiled(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, ProfileStats, error) {
+	var stats ProfileStats
+
+	// Each stage is timed from the end of the previous one
+	start := time.Now()
+	opts := verifyOptions{
+		backend: currentBackend(),
+		observe: func(stage string, _ error) {
+			now := time.Now()
+			switch stage {
+			case stageLength:
+				stats.LengthCheck = now.Sub(start)
+			case stagePublicKey:
+				stats.KeyValidation = now.Sub(start)
+			case stageSignature:
+				stats.SignatureVerify = now.Sub(start)
+			}
+			start = now
+		},
+	}
+
+	publicKey, err := verifySignatureInfo(signatureInfo, payloadHash, opts)
+	if err != nil {
+		return ExecutionAddress{}, stats, err
+	}
+
+	start = time.Now()
+	address := deriveAddress(publicKey)
+	stats.AddressDerivation = time.Since(start)
+
+	return address, stats, nil
+}
+
+// VerifyTimedResult verifies like Verify and returns the wall-clock time it took
+//
+// Unlike VerifyProfiled it reads the clock only twice, around the whole call,
+// so the overhead is a few tens of nanoseconds against tens of microseconds of
+// verification. The duration is reported on failure too.
+func VerifyTimedResult(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, time.Duration, error) {
+	start := time.Now()
+	address, err := Verify(signatureInfo, payloadHash)
+	return address, time.Since(start), err
+}
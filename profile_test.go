@@ -0,0 +1,57 @@
+package eip7980
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestVerifyProfiled tests that profiling matches Verify and times the stages reached
+func TestVerifyProfiled(t *testing.T) {
+	payloadHash := [32]byte{7}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	want, _ := Verify(signatureInfo, payloadHash)
+	address, stats, err := VerifyProfiled(signatureInfo, payloadHash)
+	if err != nil || address != want {
+		t.Fatalf("Expected %s, got %s, %v", want, address, err)
+	}
+	if stats.SignatureVerify <= 0 {
+		t.Errorf("Expected a timed verify stage, got %+v", stats)
+	}
+	if share := stats.Share(stats.SignatureVerify); share <= 0 || share > 1 {
+		t.Errorf("Share out of range: %v", share)
+	}
+
+	signatureInfo[0] ^= 0xff
+	_, stats, err = VerifyProfiled(signatureInfo, payloadHash)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if stats.AddressDerivation != 0 {
+		t.Errorf("Derivation should not run after a failed verify, got %v", stats.AddressDerivation)
+	}
+
+	_, stats, err = VerifyProfiled(signatureInfo[:10], payloadHash)
+	if err == nil || stats.KeyValidation != 0 || stats.SignatureVerify != 0 {
+		t.Errorf("Expected early exit after length check, got %+v, %v", stats, err)
+	}
+}
+
+// TestVerifyTimedResult tests that the timed variant matches Verify and reports a positive duration
+func TestVerifyTimedResult(t *testing.T) {
+	payloadHash := [32]byte{8}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	want, _ := Verify(signatureInfo, payloadHash)
+	address, elapsed, err := VerifyTimedResult(signatureInfo, payloadHash)
+	if err != nil || address != want {
+		t.Fatalf("Expected %s, got %s, %v", want, address, err)
+	}
+	if elapsed <= 0 {
+		t.Errorf("Expected a positive duration, got %v", elapsed)
+	}
+
+	if _, _, err := VerifyTimedResult(signatureInfo, [32]byte{}); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"fmt"
+)
+
+// PROVENANCE_KECCAK256 is the algorithm name NewKeccakHash records
+const PROVENANCE_KECCAK256 = "keccak256"
+
+// PayloadHashProvenance is a payload hash together with a record of how it was produced
+//
+// It is opt-in ergonomics for callers that build payload hashes in several
+// places: verification failures through VerifyWithProvenance name the
+// algorithm, which makes a hash produced with the wrong function easy to
+// spot. It does not change what verifies.
+type PayloadHashProvenance struct {
+	Hash        [32]byte // The payload hash
+	Algorithm   string   // Hash function that produced Hash, as recorded by the constructor
+	PayloadSize int      // Length of the hashed payload, or -1 if hashed outside this package
+}
+
+// NewKeccakHash hashes payload with keccak256 and records that provenance
+func NewKeccakHash(payload []byte) PayloadHashProvenance {
+	return PayloadHashProvenance{
+		Hash:        StringPayloadHash(string(payload)),
+		Algorithm:   PROVENANCE_KECCAK256,
+		PayloadSize: len(payload),
+	}
+}
+
+// NewExternalHash records a hash computed elsewhere with algo, such as "sha512/256"
+func NewExternalHash(hash [32]byte, algo string) PayloadHashProvenance {
+	if algo == "" {
+		algo = "unknown"
+	}
+	return PayloadHashProvenance{
+		Hash:        hash,
+		Algorithm:   algo,
+		PayloadSize: -1,
+	}
+}
+
+// String describes the provenance, e.g. "keccak256 of 12-byte payload"
+func (p PayloadHashProvenance) String() string {
+	algo := p.Algorithm
+	if algo == "" {
+		algo = "unknown"
+	}
+	if p.PayloadSize < 0 {
+		return fmt.Sprintf("%s, computed externally", algo)
+	}
+	return fmt.Sprintf("%s of %d-byte payload", algo, p.PayloadSize)
+}
+
+// VerifyWithProvenance verifies like Verify and annotates failures with the hash's provenance
+//
+// The returned error still wraps the underlying Verify error. When the
+// algorithm is not keccak256 the error also points out that EIP-7980
+// payload hashes are normally keccak256.
+func VerifyWithProvenance(signatureInfo []byte, hash PayloadHashProvenance) (ExecutionAddress, error) {
+	address, err := Verify(signatureInfo, hash.Hash)
+	if err == nil {
+		return address, nil
+	}
+
+	if hash.Algorithm != PROVENANCE_KECCAK256 {
+		return ExecutionAddress{}, fmt.Errorf("%w (payload hash %s: %s; EIP-7980 payload hashes are normally keccak256)", err, Hash32(hash.Hash), hash)
+	}
+	return ExecutionAddress{}, fmt.Errorf("%w (payload hash %s: %s)", err, Hash32(hash.Hash), hash)
+}
+
+// SignWithProvenance signs hash.Hash like Sign and returns the 96-byte signature_info
+func SignWithProvenance(privateKey ed25519.PrivateKey, hash PayloadHashProvenance) ([]byte, error) {
+	return Sign(privateKey, hash.Hash)
+}
@@ -0,0 +1,52 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestVerifyWithProvenance tests that a mis-hashed payload fails with an error naming the hash function
+func TestVerifyWithProvenance(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte("transfer 1 ether")
+
+	signed := NewKeccakHash(payload)
+	if signed.Hash != StringPayloadHash(string(payload)) || signed.String() != "keccak256 of 16-byte payload" {
+		t.Fatalf("Unexpected keccak provenance %+v", signed)
+	}
+	signatureInfo, err := SignWithProvenance(privateKey, signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, _ := DeriveAddress(publicKey)
+	if got, err := VerifyWithProvenance(signatureInfo, signed); err != nil || got != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+
+	// The bug this guards against: SHA-512 truncated to 32 bytes
+	digest := sha512.Sum512(payload)
+	var truncated [32]byte
+	copy(truncated[:], digest[:32])
+
+	_, err = VerifyWithProvenance(signatureInfo, NewExternalHash(truncated, "sha512[:32]"))
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Expected ErrInvalidSignature, got %v", err)
+	}
+	for _, want := range []string{"sha512[:32], computed externally", Hash32(truncated).String(), "normally keccak256"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got %q", want, err)
+		}
+	}
+
+	_, err = VerifyWithProvenance(signatureInfo, NewKeccakHash([]byte("other")))
+	if !errors.Is(err, ErrInvalidSignature) || strings.Contains(err.Error(), "normally keccak256") {
+		t.Errorf("Expected keccak failure without the algorithm hint, got %v", err)
+	}
+}
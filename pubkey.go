@@ -0,0 +1,83 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"crypto/subtle"
+	"fmt"
+
+	"filippo.io/edwards25519"
+)
+
+// Public key validation failures; each wraps ErrInvalidPublicKey
+var (
+	ErrPublicKeyLength       = fmt.Errorf("%w: wrong length", ErrInvalidPublicKey)
+	ErrPublicKeyNotOnCurve   = fmt.Errorf("%w: not a valid curve point", ErrInvalidPublicKey)
+	ErrPublicKeyNonCanonical = fmt.Errorf("%w: non-canonical point encoding", ErrInvalidPublicKey)
+	ErrPublicKeySmallOrder   = fmt.Errorf("%w: small-order point", ErrInvalidPublicKey)
+)
+
+// ValidatePublicKey checks a public key before any signature is seen
+//
+// It rejects, in order: lengths other than 32 bytes (ErrPublicKeyLength),
+// encodings that do not decode to a curve point (ErrPublicKeyNotOnCurve),
+// non-canonical encodings such as y >= p (ErrPublicKeyNonCanonical), and
+// points in the small-order torsion subgroup, including the identity
+// (ErrPublicKeySmallOrder). Every error also wraps ErrInvalidPublicKey.
+//
+// Verify applies the first three checks only, as RFC 8032 does; VerifyStrict
+// and DeriveAddress apply all four.
+func ValidatePublicKey(publicKey []byte) error {
+	var point edwards25519.Point
+	if err := decodePublicKey(&point, publicKey); err != nil {
+		return err
+	}
+
+	if isSmallOrder(&point) {
+		return ErrPublicKeySmallOrder
+	}
+
+	return nil
+}
+
+// isSmallOrder reports whether point is in the torsion subgroup, including the identity
+func isSmallOrder(point *edwards25519.Point) bool {
+	var cleared edwards25519.Point
+	return cleared.MultByCofactor(point).Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+// validatePublicKey checks that publicKey is the canonical encoding of a point on the curve
+func validatePublicKey(publicKey []byte) error {
+	var point edwards25519.Point
+	return decodePublicKey(&point, publicKey)
+}
+
+// decodePublicKey decodes publicKey into point, rejecting wrong lengths, off-curve and non-canonical encodings
+//
+// RFC 8032 Section 5.1.3 decoding fails for y >= p and for x = 0 with the sign
+// bit set. edwards25519.Point.SetBytes accepts those non-canonical encodings,
+// so the decoded point is re-encoded and compared to catch them. The point
+// is caller-provided so it can stay on the stack.
+func decodePublicKey(point *edwards25519.Point, publicKey []byte) error {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrPublicKeyLength, ed25519.PublicKeySize, len(publicKey))
+	}
+
+	return decodeCanonicalPoint(point, publicKey, ErrPublicKeyNotOnCurve, ErrPublicKeyNonCanonical)
+}
+
+// decodeCanonicalPoint decodes a 32-byte encoding into point, returning
+// notOnCurve or nonCanonical for the RFC 8032 decoding failures
+//
+// Shared by public key and signature R decoding, which differ only in the
+// errors they report.
+func decodeCanonicalPoint(point *edwards25519.Point, encoding []byte, notOnCurve, nonCanonical error) error {
+	if _, err := point.SetBytes(encoding); err != nil {
+		return notOnCurve
+	}
+
+	if subtle.ConstantTimeCompare(point.Bytes(), encoding) != 1 {
+		return nonCanonical
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package eip7980
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"filippo.io/edwards25519"
+)
+
+// invalidPublicKeyVectors are 32-byte encodings that are not canonical curve points
+func invalidPublicKeyVectors() map[string][]byte {
+	allFF := bytes.Repeat([]byte{0xff}, 32)
+
+	// y = 2 has no corresponding x on the curve
+	notOnCurve := make([]byte, 32)
+	notOnCurve[0] = 0x02
+
+	// Mid-range y with no square root for x
+	midRange := bytes.Repeat([]byte{0x55}, 32)
+	midRange[0] = 0x00
+
+	// y = p encodes the valid point y = 0 non-canonically
+	yEqualsP := bytes.Repeat([]byte{0xff}, 32)
+	yEqualsP[0] = 0xed
+	yEqualsP[31] = 0x7f
+
+	// y = 1 gives x = 0, which must not carry the sign bit
+	negativeZeroX := make([]byte, 32)
+	negativeZeroX[0] = 0x01
+	negativeZeroX[31] = 0x80
+
+	return map[string][]byte{
+		"all 0xFF":               allFF,
+		"y=2 not on curve":       notOnCurve,
+		"mid-range not on curve": midRange,
+		"y=p non-canonical":      yEqualsP,
+		"x=0 with sign bit":      negativeZeroX,
+	}
+}
+
+// TestValidatePublicKeyRejects tests explicit rejection of invalid encodings
+func TestValidatePublicKeyRejects(t *testing.T) {
+	for name, publicKey := range invalidPublicKeyVectors() {
+		if err := validatePublicKey(publicKey); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%s: expected ErrInvalidPublicKey, got %v", name, err)
+		}
+
+		signatureInfo := make([]byte, MAX_SIZE)
+		copy(signatureInfo[64:], publicKey)
+		if _, err := Verify(signatureInfo, [32]byte{}); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%s: Verify expected ErrInvalidPublicKey, got %v", name, err)
+		}
+		if _, err := NewVerifier().Verify(signatureInfo, [32]byte{}); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%s: Verifier.Verify expected ErrInvalidPublicKey, got %v", name, err)
+		}
+	}
+}
+
+// TestValidatePublicKeyAccepts tests that real keys pass validation
+func TestValidatePublicKeyAccepts(t *testing.T) {
+	signatureInfo := newTestSignatureInfo(t, [32]byte{})
+	if err := validatePublicKey(signatureInfo[64:]); err != nil {
+		t.Errorf("Generated key rejected: %v", err)
+	}
+
+	if err := validatePublicKey(make([]byte, 31)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("Short key: expected ErrInvalidPublicKey, got %v", err)
+	}
+}
+
+// TestValidatePublicKey tests every rejection class against ValidatePublicKey directly
+func TestValidatePublicKey(t *testing.T) {
+	fromHex := func(s string) []byte { return mustDecodeHex(t, s) }
+
+	for _, tc := range []struct {
+		name      string
+		publicKey []byte
+		want      error
+	}{
+		{"generated key", newTestSignatureInfo(t, [32]byte{})[64:], nil},
+		{"base point", fromHex("5866666666666666666666666666666666666666666666666666666666666666"), nil},
+		{"nil", nil, ErrPublicKeyLength},
+		{"31 bytes", make([]byte, 31), ErrPublicKeyLength},
+		{"33 bytes", make([]byte, 33), ErrPublicKeyLength},
+		{"y=2 not on curve", fromHex("0200000000000000000000000000000000000000000000000000000000000000"), ErrPublicKeyNotOnCurve},
+		{"y=p", fromHex("edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"), ErrPublicKeyNonCanonical},
+		{"y=p+1 (identity)", fromHex("eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"), ErrPublicKeyNonCanonical},
+		{"y=p+1 with sign bit", fromHex("eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), ErrPublicKeyNonCanonical},
+		{"identity with sign bit", fromHex("0100000000000000000000000000000000000000000000000000000000000080"), ErrPublicKeyNonCanonical},
+		{"identity", fromHex("0100000000000000000000000000000000000000000000000000000000000000"), ErrPublicKeySmallOrder},
+		{"order 2", fromHex("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"), ErrPublicKeySmallOrder},
+		{"order 4", fromHex("0000000000000000000000000000000000000000000000000000000000000000"), ErrPublicKeySmallOrder},
+		{"order 4 negated", fromHex("0000000000000000000000000000000000000000000000000000000000000080"), ErrPublicKeySmallOrder},
+		{"order 8", fromHex("26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc05"), ErrPublicKeySmallOrder},
+		{"order 8 negated", fromHex("26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc85"), ErrPublicKeySmallOrder},
+		{"order 8 other", fromHex("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a"), ErrPublicKeySmallOrder},
+		{"order 8 other negated", fromHex("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac03fa"), ErrPublicKeySmallOrder},
+	} {
+		err := ValidatePublicKey(tc.publicKey)
+		if !matchesSentinel(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+		if tc.want != nil && !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("%s: expected error to wrap ErrInvalidPublicKey, got %v", tc.name, err)
+		}
+		if _, derr := DeriveAddress(tc.publicKey); !matchesSentinel(derr, tc.want) {
+			t.Errorf("%s: DeriveAddress expected %v, got %v", tc.name, tc.want, derr)
+		}
+	}
+}
+
+// TestVerifyStrictSmallOrder tests that VerifyStrict rejects a signature Verify accepts for the identity key
+func TestVerifyStrictSmallOrder(t *testing.T) {
+	// R = identity, S = 0 satisfies [S]B = R + [k]A for A = identity
+	signatureInfo := make([]byte, MAX_SIZE)
+	signatureInfo[0] = 0x01
+	signatureInfo[64] = 0x01
+
+	if _, err := Verify(signatureInfo, [32]byte{}); err != nil {
+		t.Fatalf("Expected RFC 8032 Verify to accept the identity-key signature, got %v", err)
+	}
+	if _, err := VerifyStrict(signatureInfo, [32]byte{}); !errors.Is(err, ErrPublicKeySmallOrder) {
+		t.Errorf("Expected ErrPublicKeySmallOrder, got %v", err)
+	}
+
+	payloadHash := [32]byte{3}
+	valid := newTestSignatureInfo(t, payloadHash)
+	want, _ := Verify(valid, payloadHash)
+	if got, err := VerifyStrict(valid, payloadHash); err != nil || got != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+}
+
+// TestCheckSender tests that the zero address and precompiles are not valid senders
+//
+// No key is known to hash to these addresses, so VerifyStrict's sender check
+// is tested on its own.
+func TestCheckSender(t *testing.T) {
+	var first, last, afterLast ExecutionAddress
+	first[19] = 0x01
+	last[19] = LAST_PRECOMPILE
+	afterLast[19] = LAST_PRECOMPILE + 1
+
+	for name, address := range map[string]ExecutionAddress{
+		"zero address":    {},
+		"precompile 01":   first,
+		"last precompile": last,
+	} {
+		if err := checkSender(address); !errors.Is(err, ErrInvalidSender) || errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: expected ErrInvalidSender, got %v", name, err)
+		}
+	}
+
+	for name, address := range map[string]ExecutionAddress{
+		"after precompiles": afterLast,
+		"known answer":      KnownAnswerTests[0].Address,
+	} {
+		if err := checkSender(address); err != nil {
+			t.Errorf("%s: expected a valid sender, got %v", name, err)
+		}
+	}
+
+	// A bad signature is reported before the sender is looked at
+	if _, err := VerifyStrict(newTestSignatureInfo(t, [32]byte{5}), [32]byte{}); err != ErrInvalidSignature {
+		t.Errorf("Bad signature: expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+// firstUseEnv marks the subprocess TestVerifyStrictConcurrentFirstUse runs its goroutines in
+const firstUseEnv = "EIP7980_TEST_FIRST_USE"
+
+// TestVerifyStrictConcurrentFirstUse tests that package-level tables are safe
+// under simultaneous first use; run with -race to check for data races
+//
+// Earlier tests in this process have already used the tables, so the
+// goroutines run in a fresh copy of the test binary where this is the only
+// test and their calls really are the first.
+func TestVerifyStrictConcurrentFirstUse(t *testing.T) {
+	if os.Getenv(firstUseEnv) == "" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestVerifyStrictConcurrentFirstUse$", "-test.v", "-test.count=1")
+		cmd.Env = append(os.Environ(), firstUseEnv+"=1")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("Subprocess failed: %v\n%s", err, out)
+		}
+		if !bytes.Contains(out, []byte("--- PASS: TestVerifyStrictConcurrentFirstUse")) {
+			t.Fatalf("Subprocess did not run the test:\n%s", out)
+		}
+		return
+	}
+
+	kat := KnownAnswerTests[0]
+	signatureInfo := kat.SignatureInfo()
+
+	const goroutines = 64
+	start := make(chan struct{})
+	errs := make(chan error, goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			<-start
+			address, err := VerifyStrict(signatureInfo, kat.PayloadHash)
+			if err == nil && address != kat.Address {
+				err = fmt.Errorf("got %s, expected %s", address, kat.Address)
+			}
+			if err == nil && NewVerifier().ClassifyAddress(address) != ClassEOA {
+				err = fmt.Errorf("%s misclassified", address)
+			}
+			errs <- err
+		}()
+	}
+
+	close(start)
+	for i := 0; i < goroutines; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// BenchmarkVerifyStrict benchmarks strict verification end to end
+func BenchmarkVerifyStrict(b *testing.B) {
+	vector := DeterministicVectors(1)[0]
+	signatureInfo := vector.SignatureInfo()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = VerifyStrict(signatureInfo, vector.PayloadHash)
+	}
+}
+
+// BenchmarkSmallOrderCheck benchmarks the cofactor check VerifyStrict adds, on already decoded keys
+func BenchmarkSmallOrderCheck(b *testing.B) {
+	vectors := DeterministicVectors(benchWorkloadSize)
+	points := make([]edwards25519.Point, len(vectors))
+	for i, vector := range vectors {
+		if err := decodePublicKey(&points[i], vector.PublicKey[:]); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if isSmallOrder(&points[i%len(points)]) {
+			b.Fatal("Unexpected small-order key")
+		}
+	}
+}
+
+// BenchmarkCanonicalSCheck benchmarks the S < L check on the S halves of valid signatures
+//
+// It runs SignatureInfo.DecodedS, the package's own S check. Verify and
+// VerifyStrict do not check S themselves: crypto/ed25519 rejects S >= L
+// inside ed25519.Verify with the same SetCanonicalBytes call, so that cost
+// is part of BenchmarkVerifyStrict and cannot be measured on its own.
+// DecodedS also allocates the returned scalar, which the check inside
+// crypto/ed25519 does not.
+func BenchmarkCanonicalSCheck(b *testing.B) {
+	vectors := DeterministicVectors(benchWorkloadSize)
+	infos := make([]*SignatureInfo, len(vectors))
+	for i, vector := range vectors {
+		infos[i] = NewSignatureInfoFromArrays(vector.Signature, vector.PublicKey)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := infos[i%len(infos)].DecodedS(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package eip7980
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// templateFS holds the embedded report and fixture templates
+//
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// markdownEscaper backslash-escapes every CommonMark ASCII punctuation character and flattens line breaks
+//
+// Escaping the whole set, not just the characters known to matter in a table
+// cell, keeps links, images, emphasis, code spans, entities and raw HTML from
+// forming out of caller-supplied text.
+var markdownEscaper = newMarkdownEscaper()
+
+// newMarkdownEscaper builds markdownEscaper
+func newMarkdownEscaper() *strings.Replacer {
+	const punctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+	pairs := []string{"\r\n", " ", "\n", " ", "\r", " "}
+	for _, c := range punctuation {
+		pairs = append(pairs, string(c), `\`+string(c))
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// lineEscaper flattens line breaks so caller text cannot start a new line of a text report
+var lineEscaper = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+
+// reportTemplates are the text and Markdown report templates by format name
+var reportTemplates = map[string]*template.Template{
+	"text": template.Must(template.New("report.text.tmpl").
+		Funcs(template.FuncMap{"line": lineEscaper.Replace}).
+		ParseFS(templateFS, "templates/report.text.tmpl")),
+	"markdown": template.Must(template.New("report.markdown.tmpl").
+		Funcs(template.FuncMap{"markdown": markdownEscaper.Replace}).
+		ParseFS(templateFS, "templates/report.markdown.tmpl")),
+}
+
+// reportHTMLTemplate is the HTML report template; html/template escapes every field
+var reportHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/report.html.tmpl"))
+
+// Result is a renderable summary of one verification for block explorers
+type Result struct {
+	Valid         bool              // Whether the signature verified
+	Address       ExecutionAddress  // Derived sender; zero when invalid
+	Checksummed   string            // EIP-55 form of Address; empty when invalid
+	PayloadHash   Hash32            // Payload hash the signature was checked against
+	GasPenalty    int               // GAS_PENALTY charged for an Ed25519 signature
+	CanonicalKey  bool              // Public key is a canonical encoding of a curve point
+	SmallOrderKey bool              // Public key is in the small-order torsion subgroup
+	Diagnostics   string            // Verification error, empty when valid
+	ZeroHash      bool              // Warning: the payload hash is all zeros, see ErrZeroPayloadHash
+	Meta          map[string]string // Caller-supplied context, rendered escaped or with line breaks flattened
+}
+
+// NewResult verifies signatureInfo against payloadHash and summarizes the outcome
+func NewResult(signatureInfo []byte, payloadHash [32]byte, meta map[string]string) Result {
+	result := Result{
+		PayloadHash: payloadHash,
+		GasPenalty:  GAS_PENALTY,
+		ZeroHash:    payloadHash == [32]byte{},
+		Meta:        meta,
+	}
+
+	if len(signatureInfo) == MAX_SIZE {
+		keyErr := ValidatePublicKey(signatureInfo[64:])
+		result.CanonicalKey = keyErr == nil || errors.Is(keyErr, ErrPublicKeySmallOrder)
+		result.SmallOrderKey = errors.Is(keyErr, ErrPublicKeySmallOrder)
+	}
+
+	address, err := Verify(signatureInfo, payloadHash)
+	if err != nil {
+		result.Diagnostics = err.Error()
+		return result
+	}
+
+	result.Valid = true
+	result.Address = address
+	result.Checksummed = address.ChecksumString()
+	return result
+}
+
+// Render writes the result using the embedded "text", "markdown" or "html" template
+//
+// HTML output is produced with html/template, so metadata and diagnostics
+// are escaped; Markdown output backslash-escapes all CommonMark punctuation
+// in them and replaces line breaks with spaces.
+func (r Result) Render(w io.Writer, format string) error {
+	if w == nil {
+		return errNilWriter
+	}
+
+	if format == "html" {
+		return reportHTMLTemplate.Execute(w, r)
+	}
+	tmpl, ok := reportTemplates[format]
+	if !ok {
+		return fmt.Errorf("unknown report format %q: want text, markdown or html", format)
+	}
+	return tmpl.Execute(w, r)
+}
@@ -0,0 +1,99 @@
+package eip7980
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestResultRenderGolden tests each report format against its golden file
+func TestResultRenderGolden(t *testing.T) {
+	kat := KnownAnswerTests[0]
+	valid := NewResult(kat.SignatureInfo(), kat.PayloadHash, map[string]string{"block": "19000000", "tx": "0"})
+
+	tampered := kat.SignatureInfo()
+	tampered[0] ^= 0x01
+	invalid := NewResult(tampered, kat.PayloadHash, nil)
+
+	for format, ext := range map[string]string{"text": "txt", "markdown": "md", "html": "html"} {
+		var out bytes.Buffer
+		for _, result := range []Result{valid, invalid} {
+			if err := result.Render(&out, format); err != nil {
+				t.Fatalf("%s: %v", format, err)
+			}
+		}
+
+		golden := filepath.Join("testdata", "report.golden."+ext)
+		if *update {
+			if err := os.WriteFile(golden, out.Bytes(), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out.Bytes(), want) {
+			t.Errorf("%s output differs from %s:\ngot:\n%s\nwant:\n%s", format, golden, out.Bytes(), want)
+		}
+	}
+
+	if !valid.Valid || valid.Address != kat.Address || !valid.CanonicalKey || valid.SmallOrderKey {
+		t.Errorf("Unexpected report for valid vector: %+v", valid)
+	}
+	if invalid.Valid || invalid.Diagnostics == "" {
+		t.Errorf("Expected diagnostics for tampered vector, got %+v", invalid)
+	}
+	if err := valid.Render(&bytes.Buffer{}, "pdf"); err == nil {
+		t.Errorf("Expected error for unknown format")
+	}
+}
+
+// TestResultRenderEscapesMetadata tests that <script> in metadata is escaped and line breaks cannot forge text report lines
+func TestResultRenderEscapesMetadata(t *testing.T) {
+	kat := KnownAnswerTests[0]
+	payload := "<script>alert(1)</script>"
+	result := NewResult(kat.SignatureInfo(), kat.PayloadHash, map[string]string{payload: payload})
+
+	for _, format := range []string{"html", "markdown"} {
+		var out bytes.Buffer
+		if err := result.Render(&out, format); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(out.String(), "<script>") {
+			t.Errorf("%s output contains unescaped <script>:\n%s", format, out.String())
+		}
+	}
+
+	// Plain text has no escaping, but metadata must not forge report lines
+	forged := "x\r\nValid:           true\nAddress:         0x0"
+	result = NewResult(kat.SignatureInfo(), [32]byte{0xff}, map[string]string{forged: forged})
+	result.Diagnostics = forged
+	var out bytes.Buffer
+	if err := result.Render(&out, "text"); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "Valid:           true") || strings.HasPrefix(line, "Address:") || strings.Contains(line, "\r") {
+			t.Errorf("text output contains a forged line %q:\n%s", line, out.String())
+		}
+	}
+}
+
+// TestMarkdownEscaperPunctuation tests that every CommonMark punctuation character is escaped
+func TestMarkdownEscaperPunctuation(t *testing.T) {
+	const punctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+	for _, c := range punctuation {
+		if got, want := markdownEscaper.Replace(string(c)), `\`+string(c); got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+
+	got := markdownEscaper.Replace("![x](javascript:alert(1))\r\n*a* &amp;")
+	want := `\!\[x\]\(javascript\:alert\(1\)\) \*a\* \&amp\;`
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
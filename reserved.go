@@ -0,0 +1,89 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrReservedAddress is returned when a derived address falls in the reserved set
+var ErrReservedAddress = errors.New("derived address is reserved")
+
+// LAST_PRECOMPILE is the highest precompile address active as of Prague (BLS12-381 map_fp2_to_g2)
+const LAST_PRECOMPILE = 0x11
+
+// defaultReservedAddresses holds the precompile addresses 0x01..LAST_PRECOMPILE
+var defaultReservedAddresses = func() map[ExecutionAddress]struct{} {
+	reserved := make(map[ExecutionAddress]struct{}, LAST_PRECOMPILE)
+	for i := 1; i <= LAST_PRECOMPILE; i++ {
+		var address ExecutionAddress
+		address[len(address)-1] = byte(i)
+		reserved[address] = struct{}{}
+	}
+	return reserved
+}()
+
+// DefaultReservedAddresses returns a new set containing the standard precompile
+// addresses 0x01 through LAST_PRECOMPILE
+//
+// The returned map is owned by the caller and may be extended with
+// chain-specific reserved addresses before being assigned to a Verifier.
+func DefaultReservedAddresses() map[ExecutionAddress]struct{} {
+	reserved := make(map[ExecutionAddress]struct{}, len(defaultReservedAddresses))
+	for address := range defaultReservedAddresses {
+		reserved[address] = struct{}{}
+	}
+	return reserved
+}
+
+// AddressClass categorizes a derived address for policy decisions
+type AddressClass int
+
+// Address classes returned by ClassifyAddress
+const (
+	ClassEOA           AddressClass = iota // Ordinary externally owned account
+	ClassReserved                          // In the reserved set, by default the precompiles
+	ClassKnownContract                     // In the Verifier's KnownContracts registry
+)
+
+// String returns the class name
+func (c AddressClass) String() string {
+	switch c {
+	case ClassEOA:
+		return "eoa"
+	case ClassReserved:
+		return "reserved"
+	case ClassKnownContract:
+		return "known-contract"
+	default:
+		return fmt.Sprintf("AddressClass(%d)", int(c))
+	}
+}
+
+// ClassifyAddress reports whether address is reserved, a known contract, or an ordinary EOA
+//
+// Reserved takes precedence over KnownContracts. Classification is
+// informational; only RejectReservedAddresses changes what Verify accepts.
+func (v *Verifier) ClassifyAddress(address ExecutionAddress) AddressClass {
+	if v.isReserved(address) {
+		return ClassReserved
+	}
+	if v != nil {
+		if _, ok := v.KnownContracts[address]; ok {
+			return ClassKnownContract
+		}
+	}
+	return ClassEOA
+}
+
+// isReserved reports whether address is in the Verifier's reserved set
+func (v *Verifier) isReserved(address ExecutionAddress) bool {
+	var reserved map[ExecutionAddress]struct{}
+	if v != nil {
+		reserved = v.ReservedAddresses
+	}
+	if reserved == nil {
+		reserved = defaultReservedAddresses
+	}
+	_, ok := reserved[address]
+	return ok
+}
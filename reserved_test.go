@@ -0,0 +1,87 @@
+package eip7980
+
+import (
+	"testing"
+)
+
+// TestDefaultReservedAddresses tests the standard precompile set
+func TestDefaultReservedAddresses(t *testing.T) {
+	reserved := DefaultReservedAddresses()
+	if len(reserved) != LAST_PRECOMPILE {
+		t.Fatalf("Reserved set size = %d, want %d", len(reserved), LAST_PRECOMPILE)
+	}
+
+	for _, last := range []byte{0x01, 0x09, LAST_PRECOMPILE} {
+		var address ExecutionAddress
+		address[19] = last
+		if _, ok := reserved[address]; !ok {
+			t.Errorf("Precompile %s missing from default set", address)
+		}
+	}
+
+	var zero ExecutionAddress
+	if _, ok := reserved[zero]; ok {
+		t.Errorf("Zero address should not be in the precompile set")
+	}
+
+	// Callers own the returned map
+	delete(reserved, ExecutionAddress{19: 0x01})
+	if len(DefaultReservedAddresses()) != LAST_PRECOMPILE {
+		t.Errorf("Mutating the returned set changed the default")
+	}
+}
+
+// TestVerifierRejectReservedAddresses tests the reserved-address policy
+func TestVerifierRejectReservedAddresses(t *testing.T) {
+	payloadHash := [32]byte{0x07}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+	derived := deriveAddress(signatureInfo[64:])
+
+	verifier := NewVerifier()
+	verifier.RejectReservedAddresses = true
+
+	// A random key never derives to a precompile address
+	if _, err := verifier.Verify(signatureInfo, payloadHash); err != nil {
+		t.Fatalf("Default reserved set rejected a regular address: %v", err)
+	}
+
+	verifier.ReservedAddresses = DefaultReservedAddresses()
+	verifier.ReservedAddresses[derived] = struct{}{}
+	if _, err := verifier.Verify(signatureInfo, payloadHash); err != ErrReservedAddress {
+		t.Errorf("Expected ErrReservedAddress, got %v", err)
+	}
+
+	verifier.RejectReservedAddresses = false
+	if _, err := verifier.Verify(signatureInfo, payloadHash); err != nil {
+		t.Errorf("Policy disabled but Verify failed: %v", err)
+	}
+}
+
+// TestClassifyAddress tests each address class and reserved precedence
+func TestClassifyAddress(t *testing.T) {
+	precompile := ExecutionAddress{19: 0x01}
+	contract := ExecutionAddress{0: 0xc0, 19: 0x01}
+	eoa := ExecutionAddress{0: 0xe0}
+
+	verifier := NewVerifier()
+	verifier.KnownContracts = map[ExecutionAddress]struct{}{contract: {}, precompile: {}}
+
+	for _, tc := range []struct {
+		address ExecutionAddress
+		want    AddressClass
+	}{
+		{precompile, ClassReserved},
+		{ExecutionAddress{19: LAST_PRECOMPILE}, ClassReserved},
+		{contract, ClassKnownContract},
+		{eoa, ClassEOA},
+		{ExecutionAddress{}, ClassEOA},
+	} {
+		if got := verifier.ClassifyAddress(tc.address); got != tc.want {
+			t.Errorf("ClassifyAddress(%s) = %s, want %s", tc.address, got, tc.want)
+		}
+	}
+
+	if got := NewVerifier().ClassifyAddress(contract); got != ClassEOA {
+		t.Errorf("Expected %s without a registry, got %s", ClassEOA, got)
+	}
+}
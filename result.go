@@ -0,0 +1,80 @@
+package eip7980
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// VerifyResult holds the outcome of a successful verification
+type VerifyResult struct {
+	Address     ExecutionAddress // Address derived from the public key
+	PayloadHash [32]byte         // Payload hash the signature was verified against
+}
+
+// VerifyFull verifies signature_info like Verify and returns a VerifyResult
+//
+// The result echoes the payload hash that was actually verified, which helps
+// confirm the framing in pipelines with several hashing steps.
+func VerifyFull(signatureInfo []byte, payloadHash [32]byte) (VerifyResult, error) {
+	address, err := Verify(signatureInfo, payloadHash)
+	if err != nil {
+		return VerifyResult{}, err
+	}
+
+	return VerifyResult{
+		Address:     address,
+		PayloadHash: payloadHash,
+	}, nil
+}
+
+// VerifyAllForms verifies once and returns the sender in every display form
+//
+// Returns the raw address, its EIP-55 checksummed hex, and its ICAP form.
+// On failure all forms are empty.
+func VerifyAllForms(signatureInfo []byte, payloadHash [32]byte) (raw ExecutionAddress, checksummed string, icap string, err error) {
+	raw, err = Verify(signatureInfo, payloadHash)
+	if err != nil {
+		return ExecutionAddress{}, "", "", err
+	}
+	return raw, raw.ChecksumString(), raw.ICAP(), nil
+}
+
+// GasCost returns the gas EIP-7980 charges for verifying signatureInfo
+//
+// EIP-7932 lets each algorithm price its signature_info; for Ed25519 the
+// price is the flat GAS_PENALTY, but the blob must still have the right length.
+func GasCost(signatureInfo []byte) (uint64, error) {
+	if len(signatureInfo) != MAX_SIZE {
+		return 0, fmt.Errorf("invalid signature info length: expected %d, got %d", MAX_SIZE, len(signatureInfo))
+	}
+	return GAS_PENALTY, nil
+}
+
+// verifyJSONResult is the VerifyJSON output schema
+type verifyJSONResult struct {
+	Valid   bool              `json:"valid"`
+	Address *ExecutionAddress `json:"address,omitempty"`
+	Gas     uint64            `json:"gas,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// VerifyJSON verifies like VerifyFull and returns the outcome as JSON
+//
+// Success encodes as {"valid":true,"address":"0x...","gas":1000} and any
+// verification failure as {"valid":false,"error":"..."}; a failed signature
+// is not a Go error. The error result is reserved for encoding failures.
+//
+// GasCost runs first, so a blob of the wrong length is reported with its
+// pricing error before any verification work is done.
+func VerifyJSON(signatureInfo []byte, payloadHash [32]byte) ([]byte, error) {
+	gas, err := GasCost(signatureInfo)
+	if err != nil {
+		return json.Marshal(verifyJSONResult{Error: err.Error()})
+	}
+
+	result, err := VerifyFull(signatureInfo, payloadHash)
+	if err != nil {
+		return json.Marshal(verifyJSONResult{Error: err.Error()})
+	}
+	return json.Marshal(verifyJSONResult{Valid: true, Address: &result.Address, Gas: gas})
+}
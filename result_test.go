@@ -0,0 +1,89 @@
+package eip7980
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestVerifyFull tests that VerifyFull echoes the verified payload hash
+func TestVerifyFull(t *testing.T) {
+	payloadHash := [32]byte{0x01, 0x02, 0x03}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	result, err := VerifyFull(signatureInfo, payloadHash)
+	if err != nil {
+		t.Fatalf("VerifyFull failed: %v", err)
+	}
+	if result.PayloadHash != payloadHash {
+		t.Errorf("PayloadHash = %x, want %x", result.PayloadHash, payloadHash)
+	}
+	if result.Address != deriveAddress(signatureInfo[64:]) {
+		t.Errorf("Address = %s, want %s", result.Address, deriveAddress(signatureInfo[64:]))
+	}
+
+	if _, err := VerifyFull(signatureInfo, [32]byte{}); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+// TestVerifyAllForms tests every representation against a known key
+func TestVerifyAllForms(t *testing.T) {
+	kat := KnownAnswerTests[0]
+
+	raw, checksummed, icap, err := VerifyAllForms(kat.SignatureInfo(), kat.PayloadHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw != kat.Address {
+		t.Errorf("Expected %s, got %s", kat.Address, raw)
+	}
+	if checksummed != "0x431540d1f4fAecdC7f7259797f629530995e7C6C" {
+		t.Errorf("Unexpected checksummed form %s", checksummed)
+	}
+	if icap != raw.ICAP() || len(icap) != 35 {
+		t.Errorf("Unexpected ICAP form %s", icap)
+	}
+
+	raw, checksummed, icap, err = VerifyAllForms(kat.SignatureInfo()[:95], kat.PayloadHash)
+	if err == nil || raw != (ExecutionAddress{}) || checksummed != "" || icap != "" {
+		t.Errorf("Expected error and empty forms, got %s %q %q %v", raw, checksummed, icap, err)
+	}
+}
+
+// TestVerifyJSON tests the JSON shape for valid and invalid signatures
+func TestVerifyJSON(t *testing.T) {
+	kat := KnownAnswerTests[0]
+
+	for _, tc := range []struct {
+		name          string
+		signatureInfo []byte
+		want          map[string]any
+	}{
+		{"valid", kat.SignatureInfo(), map[string]any{"valid": true, "address": kat.Address.String(), "gas": float64(GAS_PENALTY)}},
+		{"bad signature", newTestSignatureInfo(t, [32]byte{1}), map[string]any{"valid": false, "error": ErrInvalidSignature.Error()}},
+		{"short", kat.SignatureInfo()[:95], map[string]any{"valid": false, "error": "invalid signature info length: expected 96, got 95"}},
+	} {
+		data, err := VerifyJSON(tc.signatureInfo, kat.PayloadHash)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("%s: output is not JSON: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: expected %v, got %s", tc.name, tc.want, data)
+		}
+	}
+}
+
+// TestGasCost tests the flat Ed25519 price and the length check
+func TestGasCost(t *testing.T) {
+	if gas, err := GasCost(make([]byte, MAX_SIZE)); err != nil || gas != GAS_PENALTY {
+		t.Errorf("Expected %d, got %d, %v", GAS_PENALTY, gas, err)
+	}
+	if _, err := GasCost(make([]byte, FRAMED_SIZE)); err == nil {
+		t.Errorf("Expected length error for a framed blob")
+	}
+}
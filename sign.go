@@ -0,0 +1,42 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+)
+
+// ErrZeroPayloadHash is returned when asked to sign an all-zero payload hash
+//
+// A zero hash almost always means the payload builder never computed it, and
+// a signature over it commits to nothing.
+var ErrZeroPayloadHash = errors.New("refusing to sign an all-zero payload hash")
+
+// SignOptions configures Sign; the zero value applies every guard
+type SignOptions struct {
+	// AllowZeroHash permits signing an all-zero payload hash, for test vectors
+	AllowZeroHash bool
+}
+
+// Sign signs payloadHash with privateKey and returns the 96-byte signature_info
+//
+// It rejects an all-zero payload hash with ErrZeroPayloadHash; use
+// SignOptions{AllowZeroHash: true}.Sign where that is intended.
+func Sign(privateKey ed25519.PrivateKey, payloadHash [32]byte) ([]byte, error) {
+	return SignOptions{}.Sign(privateKey, payloadHash)
+}
+
+// Sign signs payloadHash like the package-level Sign, applying o's guards
+func (o SignOptions) Sign(privateKey ed25519.PrivateKey, payloadHash [32]byte) ([]byte, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: expected %d, got %d", ed25519.PrivateKeySize, len(privateKey))
+	}
+	if !o.AllowZeroHash && payloadHash == ([32]byte{}) {
+		return nil, ErrZeroPayloadHash
+	}
+
+	signatureInfo := make([]byte, MAX_SIZE)
+	copy(signatureInfo[:64], ed25519.Sign(privateKey, payloadHash[:]))
+	copy(signatureInfo[64:], privateKey.Public().(ed25519.PublicKey))
+	return signatureInfo, nil
+}
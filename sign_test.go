@@ -0,0 +1,57 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestSignZeroHashGuard tests the default rejection, the override, and that Verify is unchanged
+func TestSignZeroHashGuard(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero := [32]byte{}
+
+	if _, err := Sign(privateKey, zero); !errors.Is(err, ErrZeroPayloadHash) {
+		t.Errorf("Sign: expected ErrZeroPayloadHash, got %v", err)
+	}
+	if _, err := SignWithProvenance(privateKey, NewExternalHash(zero, "none")); !errors.Is(err, ErrZeroPayloadHash) {
+		t.Errorf("SignWithProvenance: expected ErrZeroPayloadHash, got %v", err)
+	}
+
+	signatureInfo, err := SignOptions{AllowZeroHash: true}.Sign(privateKey, zero)
+	if err != nil {
+		t.Fatalf("AllowZeroHash: %v", err)
+	}
+	want, _ := DeriveAddress(publicKey)
+	if got, err := Verify(signatureInfo, zero); err != nil || got != want {
+		t.Errorf("Verify must still accept zero-hash signatures: got %s, %v", got, err)
+	}
+
+	nonZero := [32]byte{1}
+	signatureInfo, err = Sign(privateKey, nonZero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Verify(signatureInfo, nonZero); err != nil || got != want {
+		t.Errorf("Expected %s, got %s, %v", want, got, err)
+	}
+	if _, err := Sign(privateKey[:31], nonZero); err == nil {
+		t.Errorf("Expected error for short private key")
+	}
+
+	// The zero hash is flagged, not rejected, on the reporting side
+	kat := KnownAnswerTests[0]
+	if result := NewResult(kat.SignatureInfo(), kat.PayloadHash, nil); !result.Valid || !result.ZeroHash {
+		t.Errorf("Expected valid result with zero-hash warning, got %+v", result)
+	}
+	if report := FailureReport(kat.SignatureInfo(), kat.PayloadHash); !strings.Contains(report, "payload hash is all zeros") {
+		t.Errorf("Expected zero-hash warning in failure report, got:\n%s", report)
+	}
+	if result := NewResult(signatureInfo, nonZero, nil); result.ZeroHash {
+		t.Errorf("Unexpected zero-hash warning for a non-zero hash")
+	}
+}
@@ -0,0 +1,36 @@
+package eip7980
+
+import (
+	"errors"
+)
+
+// ErrNotPayloadHash is returned by VerifySolanaMessage when given a 32-byte
+// value, which is almost certainly a hash rather than a serialized message
+var ErrNotPayloadHash = errors.New("solana signatures cover the serialized message, not a 32-byte hash")
+
+// VerifySolanaMessage verifies a Solana transaction signature and maps the signer into EIP-7980
+//
+// Solana signs the serialized transaction message itself rather than a hash
+// of it, so message must be the raw message bytes. Any valid serialized
+// message is longer than 32 bytes (it contains at least one 32-byte account
+// key and the 32-byte recent blockhash), so a 32-byte input is rejected with
+// ErrNotPayloadHash to catch callers passing a payload hash by mistake; use
+// Verify for EIP-7980 payload hashes.
+//
+// The public key gets the same checks as Verify and the signature goes
+// through the configured Backend. Returns the EIP-7980 address derived from
+// the same public key.
+func VerifySolanaMessage(sig [64]byte, pub [32]byte, message []byte) (ExecutionAddress, error) {
+	if len(message) == 32 {
+		return ExecutionAddress{}, ErrNotPayloadHash
+	}
+
+	if err := validatePublicKey(pub[:]); err != nil {
+		return ExecutionAddress{}, err
+	}
+	if !verifyEd25519(currentBackend(), pub[:], message, sig[:]) {
+		return ExecutionAddress{}, ErrInvalidSignature
+	}
+
+	return deriveAddress(pub[:]), nil
+}
@@ -0,0 +1,44 @@
+package eip7980
+
+// Version is the release version of this implementation
+const Version = "v0.1.0"
+
+// EIP_NUMBER is the number of the EIP implemented by this package
+const EIP_NUMBER = 7980
+
+// Verification modes supported by this implementation
+const (
+	// ModeRFC8032 is raw Ed25519 verification as performed by crypto/ed25519
+	ModeRFC8032 = "rfc8032"
+)
+
+// CommitmentHashDefinition describes how SignatureInfo.Commitment is computed
+const CommitmentHashDefinition = "keccak256(signature || public_key)"
+
+// Spec is a machine-readable description of what this module implements,
+// for tooling that supports multiple EIP-7932 algorithms
+//
+// The JSON field names are part of the public interface and are locked by a
+// golden test; add fields rather than renaming them.
+type Spec struct {
+	EIP               int      `json:"eip"`
+	AlgType           byte     `json:"algType"`
+	GasPenalty        uint64   `json:"gasPenalty"`
+	MaxSize           int      `json:"maxSize"`
+	VerificationModes []string `json:"verificationModes"`
+	Version           string   `json:"version"`
+	CommitmentHash    string   `json:"commitmentHash"`
+}
+
+// SpecDescriptor returns the descriptor for this implementation
+func SpecDescriptor() Spec {
+	return Spec{
+		EIP:               EIP_NUMBER,
+		AlgType:           ALG_TYPE,
+		GasPenalty:        GAS_PENALTY,
+		MaxSize:           MAX_SIZE,
+		VerificationModes: []string{ModeRFC8032},
+		Version:           Version,
+		CommitmentHash:    CommitmentHashDefinition,
+	}
+}
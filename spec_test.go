@@ -0,0 +1,37 @@
+package eip7980
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// TestSpecDescriptorGolden locks the JSON schema of the spec descriptor
+func TestSpecDescriptorGolden(t *testing.T) {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(SpecDescriptor()); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join("testdata", "spec.golden.json")
+	if *update {
+		if err := os.WriteFile(golden, out.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("spec --json output changed:\ngot:\n%s\nwant:\n%s", out.Bytes(), want)
+	}
+}
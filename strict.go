@@ -0,0 +1,44 @@
+package eip7980
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSender is returned by VerifyStrict when the signature is valid but
+// the derived address cannot be a transaction sender
+var ErrInvalidSender = errors.New("derived address is not a valid sender")
+
+// VerifyStrict verifies like Verify but also rejects small-order public keys
+// and unusable senders
+//
+// RFC 8032 verification accepts keys in the torsion subgroup, for which
+// signatures are not bound to a unique signer. VerifyStrict runs the full
+// ValidatePublicKey check before verifying the signature. If the signature
+// is valid but the derived address is the zero address or a precompile
+// (0x01..LAST_PRECOMPILE), it returns ErrInvalidSender rather than
+// ErrInvalidSignature.
+func VerifyStrict(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	publicKey, err := verifySignatureInfo(signatureInfo, payloadHash, verifyOptions{backend: currentBackend(), strict: true})
+	if err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	address := deriveAddress(publicKey)
+	if err := checkSender(address); err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	return address, nil
+}
+
+// checkSender returns ErrInvalidSender if address is the zero address or a precompile
+func checkSender(address ExecutionAddress) error {
+	if address == (ExecutionAddress{}) {
+		return fmt.Errorf("%w: zero address", ErrInvalidSender)
+	}
+	if _, ok := defaultReservedAddresses[address]; ok {
+		return fmt.Errorf("%w: precompile %s", ErrInvalidSender, address)
+	}
+	return nil
+}
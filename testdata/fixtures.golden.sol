@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MIT
+// Code generated by eip7980 fixtures. DO NOT EDIT.
+pragma solidity ^0.8.0;
+
+/// @notice EIP-7980 known-answer vectors; invalid vectors must be rejected
+library EIP7980Fixtures {
+    uint256 internal constant COUNT = 16;
+
+    // "valid zero hash"
+    bytes internal constant SIGNATURE_INFO_0 = hex"3da1ebdfa96edd181dbe3659d1c051c431f056a5ad6a97a60d5cca10460438783546461e31285fc59f91c7072642745061e2451d5ff33bccd8c3c74dabcaf60a3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_0 = 0x0000000000000000000000000000000000000000000000000000000000000000;
+    address internal constant ADDRESS_0 = 0x431540d1f4fAecdC7f7259797f629530995e7C6C;
+    bool internal constant VALID_0 = true;
+
+    // "valid all-ones hash"
+    bytes internal constant SIGNATURE_INFO_1 = hex"e9f5a3bf46d30e1078dc67bfbfe2a1fcf4b3b482bebc28c4d943b9797bb43768b96054e0e016ab0857041ada6d1f738a2184ac7067a954f18deead1f3fb3850d8a88e3dd7409f195fd52db2d3cba5d72ca6709bf1d94121bf3748801b40f6f5c";
+    bytes32 internal constant PAYLOAD_HASH_1 = 0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff;
+    address internal constant ADDRESS_1 = 0x97B1C813eae702332BA3Eaa1625f942C5472626D;
+    bool internal constant VALID_1 = true;
+
+    // "valid RFC 8032 test 1 key"
+    bytes internal constant SIGNATURE_INFO_2 = hex"9cce356a79dbaa5441dad52107b2a80529c968c99394179f40eec883ee4461c7ebfeb1a92dcacec78fa55bd8a4fc6a684fafb9fadd10d7c5720c6b59967a9b07d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a";
+    bytes32 internal constant PAYLOAD_HASH_2 = 0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470;
+    address internal constant ADDRESS_2 = 0xF7CC70ADc63659b5D37671Dc2B588DB32446684A;
+    bool internal constant VALID_2 = true;
+
+    // "valid sequential hash"
+    bytes internal constant SIGNATURE_INFO_3 = hex"1bbdbb894c1f0026792d3cba5c74eed9e8c00d09680de18e8aea654ebb007f77a642da6cae45a6c782601b719acf972a984486fcf1a8e89e416f1a4679608c0e2152f8d19b791d24453242e15f2eab6cb7cffa7b6a5ed30097960e069881db12";
+    bytes32 internal constant PAYLOAD_HASH_3 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_3 = 0x40be53A7918F272A872cFad97b66506A4A99d617;
+    bool internal constant VALID_3 = true;
+
+    // "valid same key different hash"
+    bytes internal constant SIGNATURE_INFO_4 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f145083b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_4 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_4 = 0x431540d1f4fAecdC7f7259797f629530995e7C6C;
+    bool internal constant VALID_4 = true;
+
+    // "tampered R"
+    bytes internal constant SIGNATURE_INFO_5 = hex"cc7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f145083b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_5 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_5 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_5 = false;
+
+    // "tampered S"
+    bytes internal constant SIGNATURE_INFO_6 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723314952260cc6ab3410332bfab755b12d0f786b7b2f145083b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_6 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_6 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_6 = false;
+
+    // "wrong payload hash"
+    bytes internal constant SIGNATURE_INFO_7 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f145083b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_7 = 0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff;
+    address internal constant ADDRESS_7 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_7 = false;
+
+    // "wrong public key"
+    bytes internal constant SIGNATURE_INFO_8 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f145088a88e3dd7409f195fd52db2d3cba5d72ca6709bf1d94121bf3748801b40f6f5c";
+    bytes32 internal constant PAYLOAD_HASH_8 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_8 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_8 = false;
+
+    // "non-canonical S (S + L)"
+    bytes internal constant SIGNATURE_INFO_9 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da5544631189e06f96b1b5a39ca08b18cc53ec649c8410332bfab755b12d0f786b7b2f145183b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_9 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_9 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_9 = false;
+
+    // "all-zero signature"
+    bytes internal constant SIGNATURE_INFO_10 = hex"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29";
+    bytes32 internal constant PAYLOAD_HASH_10 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_10 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_10 = false;
+
+    // "public key not on curve"
+    bytes internal constant SIGNATURE_INFO_11 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f145080200000000000000000000000000000000000000000000000000000000000000";
+    bytes32 internal constant PAYLOAD_HASH_11 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_11 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_11 = false;
+
+    // "non-canonical public key (y = p)"
+    bytes internal constant SIGNATURE_INFO_12 = hex"cd7641d4fdd9fecf9b34f7e8b04e909687da548d1150bf01db964da554463118b132030f01f726723214952260cc6ab3410332bfab755b12d0f786b7b2f14508edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f";
+    bytes32 internal constant PAYLOAD_HASH_12 = 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f;
+    address internal constant ADDRESS_12 = 0x0000000000000000000000000000000000000000;
+    bool internal constant VALID_12 = false;
+
+    // "generated 0"
+    bytes internal constant SIGNATURE_INFO_13 = hex"53635fa7d4bbf27d012e27f5c19d9255ac6ab800990259aef4ed6c24bc148245014c329a73f1293714bf94afb56e45a0fdba87f0248d75be5ba8cbbd6dea120df59601c99bf1afdebb9a0d0d66598b7c30f6ebd4a85b971190930343739de4df";
+    bytes32 internal constant PAYLOAD_HASH_13 = 0x5986803d523e5a09ed8ee0f50b79bd164aaea07e94229ec38422b69aff52a8fd;
+    address internal constant ADDRESS_13 = 0x5e1F768DD7Aa1097b53a9Ec851941E39da34bad5;
+    bool internal constant VALID_13 = true;
+
+    // "generated 1"
+    bytes internal constant SIGNATURE_INFO_14 = hex"71ba6b2d820aee5e7496de99a0c2861533610337f5733fa42510ec6af5d10da8feb74867a0961bc4124b51f38b3f134940cbc2cfb7dc11f36edfc1935023280f9df94c782dca18e200dd346225c33e384a75d96da81e9cbfad3ecd41ddf5bf04";
+    bytes32 internal constant PAYLOAD_HASH_14 = 0xd9331c4128dcb6a2818bdeb922d2150eac923db8f0e2c0506b2c2f60daa55323;
+    address internal constant ADDRESS_14 = 0x5e154E066Fff41B8bF38b1fC9B82574fbd5D211b;
+    bool internal constant VALID_14 = true;
+
+    // "generated 2"
+    bytes internal constant SIGNATURE_INFO_15 = hex"fef4c2e1d1c1595f9ef66a2bdf93464878ddedf31818f811fb6c405b0fc4ca38f342ebf6715f3399619b3caf2dfd8cfdc9778005fe6457c5951240ea0209e60941bf1c994f18b37ab196cbf8926fa7a4d264d105269bb3115d384504d0765719";
+    bytes32 internal constant PAYLOAD_HASH_15 = 0xd91bb01d8ba1ba5c14cfc1be09138a36242b17574649e655c5cabcb5c16dd0a9;
+    address internal constant ADDRESS_15 = 0x274FAaa6e7EC1607010b11BAa72eEc8C87f08d35;
+    bool internal constant VALID_15 = true;
+}
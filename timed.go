@@ -0,0 +1,66 @@
+package eip7980
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// TIMESTAMP_SIZE is the size of the unix-timestamp prefix in the timed framing
+const TIMESTAMP_SIZE = 8
+
+// Errors returned by VerifyTimed; VerifyExpiring also returns ErrExpired
+var (
+	ErrExpired         = errors.New("signature timestamp has expired")
+	ErrFutureTimestamp = errors.New("signature timestamp is in the future")
+)
+
+// TimedPayloadHash returns the hash signed under the timed framing:
+// keccak256(timestamp || payloadHash), with the timestamp as 8-byte big-endian unix seconds
+func TimedPayloadHash(timestamp uint64, payloadHash [32]byte) [32]byte {
+	var prefix [TIMESTAMP_SIZE]byte
+	binary.BigEndian.PutUint64(prefix[:], timestamp)
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(prefix[:])
+	hash.Write(payloadHash[:])
+
+	var result [32]byte
+	hash.Sum(result[:0])
+	return result
+}
+
+// VerifyTimed verifies a signature carrying an embedded timestamp and rejects stale ones
+//
+// This is an optional extension framing, not part of EIP-7980. data is an
+// 8-byte big-endian unix timestamp (seconds) followed by the 96-byte
+// signature_info. The signature must be over TimedPayloadHash(timestamp,
+// payloadHash), so the timestamp is authenticated and cannot be refreshed by
+// a third party.
+//
+// Returns ErrExpired if the timestamp is more than maxAge before now, and
+// ErrFutureTimestamp if it is after now.
+func VerifyTimed(data []byte, payloadHash [32]byte, maxAge time.Duration, now time.Time) (ExecutionAddress, error) {
+	if len(data) != TIMESTAMP_SIZE+MAX_SIZE {
+		return ExecutionAddress{}, fmt.Errorf("invalid timed signature length: expected %d, got %d", TIMESTAMP_SIZE+MAX_SIZE, len(data))
+	}
+
+	timestamp := binary.BigEndian.Uint64(data[:TIMESTAMP_SIZE])
+
+	address, err := Verify(data[TIMESTAMP_SIZE:], TimedPayloadHash(timestamp, payloadHash))
+	if err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	if timestamp > uint64(now.Unix()) {
+		return ExecutionAddress{}, ErrFutureTimestamp
+	}
+	if now.Sub(time.Unix(int64(timestamp), 0)) > maxAge {
+		return ExecutionAddress{}, ErrExpired
+	}
+
+	return address, nil
+}
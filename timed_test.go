@@ -0,0 +1,53 @@
+package eip7980
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// newTimedSignature signs payloadHash under the timed framing at timestamp
+func newTimedSignature(t *testing.T, timestamp uint64, payloadHash [32]byte) []byte {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signedHash := TimedPayloadHash(timestamp, payloadHash)
+
+	data := make([]byte, TIMESTAMP_SIZE+MAX_SIZE)
+	binary.BigEndian.PutUint64(data, timestamp)
+	copy(data[TIMESTAMP_SIZE:], ed25519.Sign(privateKey, signedHash[:]))
+	copy(data[TIMESTAMP_SIZE+64:], publicKey)
+	return data
+}
+
+// TestVerifyTimed tests freshness checks on timed signatures
+func TestVerifyTimed(t *testing.T) {
+	now := time.Unix(1_750_000_000, 0)
+	payloadHash := [32]byte{0x11}
+	data := newTimedSignature(t, uint64(now.Add(-30*time.Second).Unix()), payloadHash)
+
+	if _, err := VerifyTimed(data, payloadHash, time.Minute, now); err != nil {
+		t.Errorf("Fresh signature rejected: %v", err)
+	}
+	if _, err := VerifyTimed(data, payloadHash, 10*time.Second, now); err != ErrExpired {
+		t.Errorf("Expected ErrExpired, got %v", err)
+	}
+	if _, err := VerifyTimed(data, payloadHash, time.Minute, now.Add(-time.Minute)); err != ErrFutureTimestamp {
+		t.Errorf("Expected ErrFutureTimestamp, got %v", err)
+	}
+
+	// Refreshing the timestamp invalidates the signature
+	binary.BigEndian.PutUint64(data, uint64(now.Unix()))
+	if _, err := VerifyTimed(data, payloadHash, time.Minute, now); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for altered timestamp, got %v", err)
+	}
+
+	if _, err := VerifyTimed(data[:MAX_SIZE], payloadHash, time.Minute, now); err == nil {
+		t.Errorf("Expected length error")
+	}
+}
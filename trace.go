@@ -0,0 +1,31 @@
+package eip7980
+
+import (
+	"context"
+)
+
+// TraceFunc starts a trace span for a verification and returns a function
+// that ends it with the verification error (nil on success)
+//
+// It is a plain function hook so tracing systems such as OpenTelemetry can
+// be attached without this package importing them. The returned end
+// function may be nil.
+type TraceFunc func(ctx context.Context) (end func(err error))
+
+// VerifyContext verifies like Verify and reports the call to v.Trace, if set
+//
+// The context is only passed to the trace hook; verification itself is not
+// cancellable.
+func (v *Verifier) VerifyContext(ctx context.Context, signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	var end func(error)
+	if v != nil && v.Trace != nil {
+		end = v.Trace(ctx)
+	}
+
+	address, err := v.Verify(signatureInfo, payloadHash)
+
+	if end != nil {
+		end(err)
+	}
+	return address, err
+}
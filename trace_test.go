@@ -0,0 +1,60 @@
+package eip7980
+
+import (
+	"context"
+	"testing"
+)
+
+type traceKey struct{}
+
+// TestVerifyContextTrace tests that the trace hook sees the context and result
+func TestVerifyContextTrace(t *testing.T) {
+	payloadHash := [32]byte{0x05}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	var started, ended int
+	var lastErr error
+	verifier := NewVerifier()
+	verifier.Trace = func(ctx context.Context) func(error) {
+		if ctx.Value(traceKey{}) != "span-parent" {
+			t.Errorf("Trace hook did not receive the caller's context")
+		}
+		started++
+		return func(err error) {
+			ended++
+			lastErr = err
+		}
+	}
+
+	ctx := context.WithValue(context.Background(), traceKey{}, "span-parent")
+
+	if _, err := verifier.VerifyContext(ctx, signatureInfo, payloadHash); err != nil {
+		t.Fatal(err)
+	}
+	if started != 1 || ended != 1 || lastErr != nil {
+		t.Errorf("Success: started=%d ended=%d err=%v", started, ended, lastErr)
+	}
+
+	if _, err := verifier.VerifyContext(ctx, signatureInfo, [32]byte{}); err != ErrInvalidSignature {
+		t.Fatalf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if started != 2 || ended != 2 || lastErr != ErrInvalidSignature {
+		t.Errorf("Failure: started=%d ended=%d err=%v", started, ended, lastErr)
+	}
+}
+
+// TestVerifyContextNilTrace tests that missing hooks are tolerated
+func TestVerifyContextNilTrace(t *testing.T) {
+	payloadHash := [32]byte{0x05}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	verifier := NewVerifier()
+	if _, err := verifier.VerifyContext(context.Background(), signatureInfo, payloadHash); err != nil {
+		t.Errorf("Nil Trace: %v", err)
+	}
+
+	verifier.Trace = func(context.Context) func(error) { return nil }
+	if _, err := verifier.VerifyContext(context.Background(), signatureInfo, payloadHash); err != nil {
+		t.Errorf("Nil end function: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package eip7980
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Verifier performs EIP-7980 verification without heap allocations
+//
+// The Keccak256 state and digest buffer are allocated once in NewVerifier and
+// reused by every call, so Verify does zero heap allocations after
+// construction. This suits memory-constrained targets where GC is expensive.
+//
+// A Verifier is not safe for concurrent use; create one per goroutine. The
+// zero value is usable, and a nil *Verifier verifies like the package-level
+// Verify.
+type Verifier struct {
+	// RejectReservedAddresses makes Verify fail with ErrReservedAddress when
+	// the derived address is in ReservedAddresses
+	RejectReservedAddresses bool
+
+	// ReservedAddresses is the reserved set checked by RejectReservedAddresses;
+	// nil means the standard precompile range (see DefaultReservedAddresses)
+	ReservedAddresses map[ExecutionAddress]struct{}
+
+	// KnownContracts is an optional registry of deployed contract addresses
+	// reported as ClassKnownContract by ClassifyAddress
+	KnownContracts map[ExecutionAddress]struct{}
+
+	// Trace, if set, is called around each VerifyContext call
+	Trace TraceFunc
+
+	// Backend, if set, checks signatures instead of the package Backend (see SetBackend)
+	Backend Backend
+
+	hasher hash.Hash // Keccak256 state reused across calls
+	digest [32]byte  // Keccak256 output buffer
+}
+
+// NewVerifier creates a Verifier with preallocated buffers
+func NewVerifier() *Verifier {
+	return &Verifier{
+		hasher: sha3.NewLegacyKeccak256(),
+	}
+}
+
+// Verify implements the EIP-7980 verification algorithm using the Verifier's buffers
+//
+// It accepts and rejects exactly the same inputs as the package-level Verify,
+// and has the same aliasing contract: signatureInfo is read in place and must
+// not be modified until Verify returns. Building with the eip7980_debugchecks
+// tag makes Verify, like every other entry point, panic when that contract is
+// broken.
+func (v *Verifier) Verify(signatureInfo []byte, payloadHash [32]byte) (ExecutionAddress, error) {
+	if v == nil {
+		return Verify(signatureInfo, payloadHash)
+	}
+	publicKey, err := verifySignatureInfo(signatureInfo, payloadHash, verifyOptions{backend: v.backend()})
+	if err != nil {
+		return ExecutionAddress{}, err
+	}
+
+	address := v.deriveAddress(publicKey)
+	if v.RejectReservedAddresses && v.isReserved(address) {
+		return ExecutionAddress{}, ErrReservedAddress
+	}
+
+	return address, nil
+}
+
+// backend returns the Verifier's Backend, falling back to the package Backend
+func (v *Verifier) backend() Backend {
+	if v.Backend != nil {
+		return v.Backend
+	}
+	return currentBackend()
+}
+
+// deriveAddress computes the last 20 bytes of keccak256(publicKey) into the reused digest buffer
+func (v *Verifier) deriveAddress(publicKey []byte) ExecutionAddress {
+	if v.hasher == nil {
+		v.hasher = sha3.NewLegacyKeccak256()
+	}
+	v.hasher.Reset()
+	v.hasher.Write(publicKey)
+	v.hasher.Sum(v.digest[:0])
+
+	var address ExecutionAddress
+	copy(address[:], v.digest[len(v.digest)-20:])
+	return address
+}
@@ -0,0 +1,49 @@
+package eip7980
+
+import (
+	"testing"
+)
+
+// TestVerifierMatchesVerify tests that Verifier agrees with the package-level Verify
+func TestVerifierMatchesVerify(t *testing.T) {
+	payloadHash := [32]byte{0xaa}
+	signatureInfo := newTestSignatureInfo(t, payloadHash)
+
+	verifier := NewVerifier()
+
+	want, err := Verify(signatureInfo, payloadHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Run twice to exercise hasher reuse
+	for i := 0; i < 2; i++ {
+		got, err := verifier.Verify(signatureInfo, payloadHash)
+		if err != nil {
+			t.Fatalf("Verifier.Verify failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("Address = %s, want %s", got, want)
+		}
+	}
+
+	if _, err := verifier.Verify(signatureInfo, [32]byte{}); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+	if _, err := verifier.Verify(signatureInfo[:50], payloadHash); err == nil {
+		t.Errorf("Expected length error")
+	}
+}
+
+// BenchmarkVerifierVerify benchmarks the allocation-free Verifier
+func BenchmarkVerifierVerify(b *testing.B) {
+	payloadHash := [32]byte{}
+	signatureInfo := newTestSignatureInfo(b, payloadHash)
+	verifier := NewVerifier()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = verifier.Verify(signatureInfo, payloadHash)
+	}
+}
@@ -0,0 +1,51 @@
+// Package workload generates deterministic synthetic EIP-7980 verification workloads
+//
+// A Generator produces a stream of batch items with ground-truth labels from
+// a seed, so benchmarks and tests can share realistic, reproducible inputs:
+// skewed key reuse, a configurable share of invalid signatures, and a
+// configurable share of malformed encodings.
+//
+// The generator itself lives in the eip7980 package, which uses it for
+// RunBench, CalibrationRun and DeterministicVectors; this package gives it
+// short names for callers outside the module.
+package workload
+
+import (
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+)
+
+// KeyReuse selects how signing keys are drawn for successive items
+type KeyReuse = eip7980.KeyReuse
+
+// Key reuse distributions
+const (
+	Uniform    = eip7980.KeyReuseUniform    // Every key equally likely
+	Zipfian    = eip7980.KeyReuseZipfian    // A few hot keys sign most items
+	Sequential = eip7980.KeyReuseSequential // Keys in order, wrapping around
+)
+
+// Label is the ground truth for a generated item
+type Label = eip7980.ItemLabel
+
+// Item labels
+const (
+	Valid     = eip7980.ItemValid     // Verifies to Item.Address
+	Invalid   = eip7980.ItemInvalid   // Well-formed but the signature does not verify
+	Malformed = eip7980.ItemMalformed // signature_info is not MAX_SIZE bytes
+)
+
+// Config configures a Generator
+type Config = eip7980.GeneratorConfig
+
+// Item is a generated batch item with its expected outcome
+type Item = eip7980.GeneratedItem
+
+// Generator produces a deterministic stream of Items
+//
+// A Generator is not safe for concurrent use.
+type Generator = eip7980.Generator
+
+// New creates a Generator, deriving its signing keys from cfg.Seed
+func New(cfg Config) (*Generator, error) {
+	return eip7980.NewGenerator(cfg)
+}
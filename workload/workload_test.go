@@ -0,0 +1,121 @@
+package workload
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	eip7980 "github.com/EIPs-CodeLab/eip-7980"
+)
+
+// TestGeneratorDeterministic tests that equal seeds produce identical streams
+func TestGeneratorDeterministic(t *testing.T) {
+	cfg := Config{Seed: 42, KeyReuse: Zipfian, InvalidRate: 0.1, MalformedRate: 0.05}
+
+	a, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := New(cfg)
+
+	for i := 0; i < 1000; i++ {
+		x, y := a.Next(), b.Next()
+		if !bytes.Equal(x.SignatureInfo, y.SignatureInfo) || x.PayloadHash != y.PayloadHash || x.Label != y.Label || x.KeyIndex != y.KeyIndex {
+			t.Fatalf("Item %d differs between generators with the same seed", i)
+		}
+	}
+
+	cfg.Seed = 43
+	c, _ := New(cfg)
+	first, _ := New(Config{Seed: 42, KeyReuse: Zipfian, InvalidRate: 0.1, MalformedRate: 0.05})
+	if bytes.Equal(c.Next().SignatureInfo, first.Next().SignatureInfo) {
+		t.Errorf("Different seeds produced the same first item")
+	}
+}
+
+// TestGeneratorRates tests that labels are ground truth and rates are honored within tolerance
+func TestGeneratorRates(t *testing.T) {
+	const n = 2000
+	generator, err := New(Config{Seed: 7, InvalidRate: 0.2, MalformedRate: 0.1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[Label]int)
+	for _, item := range generator.Items(n) {
+		counts[item.Label]++
+
+		address, err := eip7980.Verify(item.SignatureInfo, item.PayloadHash)
+		switch item.Label {
+		case Valid:
+			if err != nil || address != item.Address {
+				t.Fatalf("Valid item rejected: %v", err)
+			}
+		case Invalid:
+			if !errors.Is(err, eip7980.ErrInvalidSignature) && !errors.Is(err, eip7980.ErrInvalidPublicKey) {
+				t.Fatalf("Invalid item: expected a verification failure, got %v", err)
+			}
+		case Malformed:
+			if len(item.SignatureInfo) == eip7980.MAX_SIZE || err == nil {
+				t.Fatalf("Malformed item has length %d, err %v", len(item.SignatureInfo), err)
+			}
+		}
+	}
+
+	for label, want := range map[Label]float64{Valid: 0.7, Invalid: 0.2, Malformed: 0.1} {
+		got := float64(counts[label]) / n
+		if got < want-0.04 || got > want+0.04 {
+			t.Errorf("%s rate = %.3f, want %.2f ± 0.04", label, got, want)
+		}
+	}
+}
+
+// TestGeneratorZipfian tests that zipfian reuse concentrates on hot keys
+func TestGeneratorZipfian(t *testing.T) {
+	generator, err := New(Config{Seed: 1, Keys: 100, KeyReuse: Zipfian})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hot := 0
+	for _, item := range generator.Items(1000) {
+		if item.KeyIndex < 10 {
+			hot++
+		}
+	}
+	if hot < 500 {
+		t.Errorf("Expected most items from the 10 hottest keys, got %d of 1000", hot)
+	}
+}
+
+// TestNewRejectsBadConfig tests configuration validation
+func TestNewRejectsBadConfig(t *testing.T) {
+	for name, cfg := range map[string]Config{
+		"negative keys":   {Keys: -1},
+		"rates above one": {InvalidRate: 0.6, MalformedRate: 0.6},
+		"negative rate":   {InvalidRate: -0.1},
+		"NaN rate":        {InvalidRate: math.NaN()},
+		"NaN plus valid":  {InvalidRate: 0.1, MalformedRate: math.NaN()},
+		"infinite rate":   {MalformedRate: math.Inf(1)},
+		"unknown reuse":   {KeyReuse: "pareto"},
+	} {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+// TestGeneratorSequential tests that sequential reuse cycles through the keys in order
+func TestGeneratorSequential(t *testing.T) {
+	generator, err := New(Config{Seed: 3, Keys: 4, KeyReuse: Sequential})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, item := range generator.Items(10) {
+		if item.KeyIndex != i%4 {
+			t.Errorf("Item %d: expected key %d, got %d", i, i%4, item.KeyIndex)
+		}
+	}
+}